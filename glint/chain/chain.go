@@ -0,0 +1,301 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// DefaultMaxDepth bounds the number of certificates in a path, leaf and root
+// included.
+const DefaultMaxDepth = 8
+
+// ErrNoTrustedRoot is wrapped by the error recorded on paths that do not end
+// at a certificate in the root pool.
+var ErrNoTrustedRoot = errors.New("no path to a trusted root")
+
+// A Path is one candidate certification path, ordered from the leaf to the
+// last issuer found. It is Anchored when that issuer is in the root pool.
+// Errors holds every problem found verifying the path; a path without errors
+// is valid.
+type Path struct {
+	Certificates []*x509.Certificate
+	Anchored     bool
+	Errors       []error
+}
+
+// Valid returns true if the path is anchored and verified without errors.
+func (p *Path) Valid() bool {
+	return p.Anchored && len(p.Errors) == 0
+}
+
+// LinksVerified returns true if every link of the path verified, whether or
+// not the path is anchored.
+func (p *Path) LinksVerified() bool {
+	for _, err := range p.Errors {
+		if !errors.Is(err, ErrNoTrustedRoot) {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrorStrings returns the verification errors of the path as strings.
+func (p *Path) ErrorStrings() []string {
+	out := make([]string, 0, len(p.Errors))
+	for _, err := range p.Errors {
+		out = append(out, err.Error())
+	}
+	return out
+}
+
+// Fingerprints returns the hex SHA-256 fingerprints of the certificates in
+// the path, leaf first.
+func (p *Path) Fingerprints() []string {
+	out := make([]string, 0, len(p.Certificates))
+	for _, c := range p.Certificates {
+		out = append(out, c.FingerprintSHA256.Hex())
+	}
+	return out
+}
+
+// String returns the subject common names of the path, leaf first.
+func (p *Path) String() string {
+	names := make([]string, 0, len(p.Certificates))
+	for _, c := range p.Certificates {
+		names = append(names, fmt.Sprintf("%q", c.Subject.CommonName))
+	}
+	return strings.Join(names, " -> ")
+}
+
+// A Builder builds candidate paths from a leaf through Intermediates to
+// Roots.
+type Builder struct {
+	Intermediates *Pool
+	Roots         *Pool
+	MaxDepth      int
+}
+
+// NewBuilder returns a Builder over the given pools. Either may be nil.
+func NewBuilder(intermediates *Pool, roots *Pool) *Builder {
+	if intermediates == nil {
+		intermediates = NewPool()
+	}
+	if roots == nil {
+		roots = NewPool()
+	}
+	return &Builder{Intermediates: intermediates, Roots: roots, MaxDepth: DefaultMaxDepth}
+}
+
+var defaultBuilder = NewBuilder(nil, nil)
+
+// Default returns the Builder used by lints that need a certification path.
+// Until SetDefault is called it has empty pools and builds no paths.
+func Default() *Builder {
+	return defaultBuilder
+}
+
+// SetDefault replaces the Builder returned by Default. It is normally called
+// once, before linting, with the pools given on the command line.
+func SetDefault(b *Builder) {
+	defaultBuilder = b
+}
+
+// Empty returns true if the builder has no certificates to build paths from.
+func (b *Builder) Empty() bool {
+	return b.Intermediates.Len() == 0 && b.Roots.Len() == 0
+}
+
+// issuers returns the candidate issuers of c in pool. Candidates are matched
+// on the issuer name, byte for byte, and filtered by the authority key
+// identifier when both sides carry one. If no name matches, certificates
+// whose subject key identifier matches the authority key identifier are
+// returned instead, so that paths broken only by a name mismatch are still
+// found and reported.
+func issuers(c *x509.Certificate, pool *Pool) []*x509.Certificate {
+	var out []*x509.Certificate
+	for _, cand := range pool.bySubject[string(c.RawIssuer)] {
+		if len(c.AuthorityKeyId) > 0 && len(cand.SubjectKeyId) > 0 && !bytes.Equal(c.AuthorityKeyId, cand.SubjectKeyId) {
+			continue
+		}
+		out = append(out, cand)
+	}
+	if len(out) == 0 && len(c.AuthorityKeyId) > 0 {
+		out = append(out, pool.bySKI[string(c.AuthorityKeyId)]...)
+	}
+	return out
+}
+
+// Issuers returns the candidate issuers of c in the root and intermediate
+// pools, roots first.
+func (b *Builder) Issuers(c *x509.Certificate) []*x509.Certificate {
+	return append(issuers(c, b.Roots), issuers(c, b.Intermediates)...)
+}
+
+// Build returns every candidate path for leaf. Each branch ends at a root,
+// at a certificate with no further issuer, or at MaxDepth. Anchored paths
+// come first, then shorter paths. A leaf that is itself a root yields a
+// single one-certificate path.
+func (b *Builder) Build(leaf *x509.Certificate) []*Path {
+	if leaf == nil || b.Empty() {
+		return nil
+	}
+	if b.Roots.Contains(leaf) {
+		return []*Path{b.verify([]*x509.Certificate{leaf}, true)}
+	}
+	maxDepth := b.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	var paths []*Path
+	var walk func(chain []*x509.Certificate)
+	walk = func(chain []*x509.Certificate) {
+		last := chain[len(chain)-1]
+		extended := false
+		if len(chain) < maxDepth {
+			for _, root := range issuers(last, b.Roots) {
+				if inChain(chain, root) {
+					continue
+				}
+				paths = append(paths, b.verify(appendCert(chain, root), true))
+				extended = true
+			}
+			for _, inter := range issuers(last, b.Intermediates) {
+				if inChain(chain, inter) || b.Roots.Contains(inter) {
+					continue
+				}
+				walk(appendCert(chain, inter))
+				extended = true
+			}
+		}
+		if !extended {
+			paths = append(paths, b.verify(chain, false))
+		}
+	}
+	walk([]*x509.Certificate{leaf})
+	sortPaths(paths)
+	return paths
+}
+
+// Choose returns the preferred path: the shortest valid path, or failing
+// that the anchored path with the fewest errors, or failing that the longest
+// unanchored path. It returns nil if paths is empty.
+func Choose(paths []*Path) *Path {
+	var best *Path
+	for _, p := range paths {
+		if best == nil || better(p, best) {
+			best = p
+		}
+	}
+	return best
+}
+
+func better(a, b *Path) bool {
+	if a.Valid() != b.Valid() {
+		return a.Valid()
+	}
+	if a.Anchored != b.Anchored {
+		return a.Anchored
+	}
+	if !a.Anchored {
+		return len(a.Certificates) > len(b.Certificates)
+	}
+	if len(a.Errors) != len(b.Errors) {
+		return len(a.Errors) < len(b.Errors)
+	}
+	return len(a.Certificates) < len(b.Certificates)
+}
+
+func sortPaths(paths []*Path) {
+	// Insertion sort keeps the discovery order among equal paths.
+	for i := 1; i < len(paths); i++ {
+		for j := i; j > 0 && lessPath(paths[j], paths[j-1]); j-- {
+			paths[j], paths[j-1] = paths[j-1], paths[j]
+		}
+	}
+}
+
+func lessPath(a, b *Path) bool {
+	if a.Anchored != b.Anchored {
+		return a.Anchored
+	}
+	return len(a.Certificates) < len(b.Certificates)
+}
+
+// verify checks every link of chain and returns it as a Path. For code
+// signing the issuer must have been valid when the child was issued, since
+// signatures are trusted long after certificates expire, so validity is
+// checked at each child's notBefore rather than at the current time.
+func (b *Builder) verify(chain []*x509.Certificate, anchored bool) *Path {
+	p := &Path{Certificates: chain, Anchored: anchored}
+	for i := 0; i+1 < len(chain); i++ {
+		child, parent := chain[i], chain[i+1]
+		if err := checkSignatureFrom(child, parent); err != nil {
+			p.Errors = append(p.Errors, fmt.Errorf("%q: signature from %q: %s", child.Subject.CommonName, parent.Subject.CommonName, err))
+		}
+		if child.NotBefore.Before(parent.NotBefore) || child.NotBefore.After(parent.NotAfter) {
+			p.Errors = append(p.Errors, fmt.Errorf("%q: issued outside the validity of %q", child.Subject.CommonName, parent.Subject.CommonName))
+		}
+		// chain[1:i+1] are the i CA certificates between parent and the leaf.
+		if parent.BasicConstraintsValid && (parent.MaxPathLen > 0 || parent.MaxPathLenZero) && i > parent.MaxPathLen {
+			p.Errors = append(p.Errors, fmt.Errorf("%q: path length constraint %d exceeded", parent.Subject.CommonName, parent.MaxPathLen))
+		}
+	}
+	if !anchored {
+		last := chain[len(chain)-1]
+		p.Errors = append(p.Errors, fmt.Errorf("%q: %w", last.Subject.CommonName, ErrNoTrustedRoot))
+	}
+	return p
+}
+
+// checkSignatureFrom is child.CheckSignatureFrom(parent), except that an
+// issuer name that differs from the subject of parent only in its encoding
+// is accepted, as RFC 5280 7.1 name chaining does. Whether the encodings are
+// identical is left to the lints.
+func checkSignatureFrom(child, parent *x509.Certificate) error {
+	if bytes.Equal(child.RawIssuer, parent.RawSubject) {
+		return child.CheckSignatureFrom(parent)
+	}
+	if normalizeName(child.Issuer.String()) != normalizeName(parent.Subject.String()) {
+		return errors.New("issuer name does not match the subject of the issuer")
+	}
+	reencoded := *parent
+	reencoded.RawSubject = child.RawIssuer
+	return child.CheckSignatureFrom(&reencoded)
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func inChain(chain []*x509.Certificate, c *x509.Certificate) bool {
+	for _, in := range chain {
+		if bytes.Equal(in.Raw, c.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendCert(chain []*x509.Certificate, c *x509.Certificate) []*x509.Certificate {
+	out := make([]*x509.Certificate, len(chain), len(chain)+1)
+	copy(out, chain)
+	return append(out, c)
+}
@@ -0,0 +1,200 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package chain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+var testStart = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// issue signs a certificate for name and key. A nil issuer self-signs.
+func issue(t *testing.T, name string, key *ecdsa.PrivateKey, issuer *testCA, isCA bool, notBefore time.Time) *x509.Certificate {
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.AddDate(5, 0, 0),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		SubjectKeyId:          []byte(name),
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	}
+	parent, signer := tmpl, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+		tmpl.AuthorityKeyId = issuer.cert.SubjectKeyId
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func newCA(t *testing.T, name string, issuer *testCA) *testCA {
+	key := newKey(t)
+	return &testCA{cert: issue(t, name, key, issuer, true, testStart), key: key}
+}
+
+func TestBuildCrossSigned(t *testing.T) {
+	rootA := newCA(t, "Root A", nil)
+	rootB := newCA(t, "Root B", nil)
+	inter := newCA(t, "Intermediate", rootA)
+	// Same subject and key as inter, issued by rootB.
+	cross := &testCA{cert: issue(t, "Intermediate", inter.key, rootB, true, testStart), key: inter.key}
+	leaf := issue(t, "Leaf", newKey(t), inter, false, testStart.AddDate(1, 0, 0))
+
+	intermediates := NewPool()
+	intermediates.AddCert(inter.cert)
+	intermediates.AddCert(cross.cert)
+	roots := NewPool()
+	roots.AddCert(rootA.cert)
+
+	paths := NewBuilder(intermediates, roots).Build(leaf)
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %d", len(paths))
+	}
+	if !paths[0].Valid() || len(paths[0].Certificates) != 3 {
+		t.Errorf("expected a valid path through Root A first, got %s %v", paths[0], paths[0].Errors)
+	}
+	if paths[1].Anchored || paths[1].Valid() {
+		t.Errorf("expected the cross-signed path to be unanchored, got %s", paths[1])
+	}
+	if chosen := Choose(paths); chosen != paths[0] {
+		t.Errorf("expected the valid path to be chosen, got %s", chosen)
+	}
+
+	roots.AddCert(rootB.cert)
+	valid := 0
+	for _, p := range NewBuilder(intermediates, roots).Build(leaf) {
+		if p.Valid() {
+			valid++
+		}
+	}
+	if valid != 2 {
+		t.Errorf("expected 2 valid paths once Root B is trusted, got %d", valid)
+	}
+}
+
+func TestBuildIssuerNameMismatch(t *testing.T) {
+	root := newCA(t, "Root", nil)
+	inter := newCA(t, "Intermediate", root)
+	// Signed by inter's key, with inter's key identifier, under another name.
+	renamed := *inter.cert
+	renamed.RawSubject = nil
+	renamed.Subject = pkix.Name{CommonName: "Intermediate Renamed"}
+	leaf := issue(t, "Leaf", newKey(t), &testCA{cert: &renamed, key: inter.key}, false, testStart.AddDate(1, 0, 0))
+
+	intermediates := NewPool()
+	intermediates.AddCert(inter.cert)
+	roots := NewPool()
+	roots.AddCert(root.cert)
+
+	paths := NewBuilder(intermediates, roots).Build(leaf)
+	if len(paths) != 1 {
+		t.Fatalf("expected 1 path, got %d", len(paths))
+	}
+	if !paths[0].Anchored || paths[0].Valid() {
+		t.Errorf("expected an anchored path with errors, got %s %v", paths[0], paths[0].Errors)
+	}
+}
+
+func TestBuildIssuerNameReencoded(t *testing.T) {
+	root := newCA(t, "Root", nil)
+	inter := newCA(t, "Intermediate", root)
+	// The same name as inter's subject, in upper case.
+	reencoded := *inter.cert
+	reencoded.RawSubject = nil
+	reencoded.Subject = pkix.Name{CommonName: "INTERMEDIATE"}
+	leaf := issue(t, "Leaf", newKey(t), &testCA{cert: &reencoded, key: inter.key}, false, testStart.AddDate(1, 0, 0))
+
+	intermediates := NewPool()
+	intermediates.AddCert(inter.cert)
+	roots := NewPool()
+	roots.AddCert(root.cert)
+
+	paths := NewBuilder(intermediates, roots).Build(leaf)
+	if len(paths) != 1 || !paths[0].Valid() {
+		t.Fatalf("expected 1 valid path, got %d", len(paths))
+	}
+}
+
+func TestBuildIssuedOutsideIssuerValidity(t *testing.T) {
+	root := newCA(t, "Root", nil)
+	inter := newCA(t, "Intermediate", root)
+	leaf := issue(t, "Leaf", newKey(t), inter, false, testStart.AddDate(-1, 0, 0))
+
+	intermediates := NewPool()
+	intermediates.AddCert(inter.cert)
+	roots := NewPool()
+	roots.AddCert(root.cert)
+
+	chosen := Choose(NewBuilder(intermediates, roots).Build(leaf))
+	if chosen == nil || chosen.Valid() || len(chosen.Errors) != 1 {
+		t.Errorf("expected one validity error, got %v", chosen)
+	}
+}
+
+func TestBuildEmptyPools(t *testing.T) {
+	root := newCA(t, "Root", nil)
+	if paths := Default().Build(root.cert); paths != nil {
+		t.Errorf("expected no paths from the default builder, got %d", len(paths))
+	}
+}
+
+func TestAppendFromBytesBundle(t *testing.T) {
+	rootA := newCA(t, "Root A", nil)
+	rootB := newCA(t, "Root B", nil)
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootA.cert.Raw})
+	bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootB.cert.Raw})...)
+	bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootA.cert.Raw})...)
+
+	pool := NewPool()
+	if _, err := pool.AppendFromBytes(bundle); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Len() != 2 {
+		t.Errorf("expected 2 certificates, got %d", pool.Len())
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package chain builds certification paths offline, from intermediates and
+// roots loaded from local directories and bundle files.
+package chain
+
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A Pool is a set of certificates indexed for issuer lookup. Unlike
+// x509.CertPool it keeps every certificate sharing a subject, so that
+// cross-signed and re-keyed CAs all become candidate issuers.
+type Pool struct {
+	certs     []*x509.Certificate
+	seen      map[string]bool
+	bySubject map[string][]*x509.Certificate
+	bySKI     map[string][]*x509.Certificate
+}
+
+// NewPool returns an empty Pool.
+func NewPool() *Pool {
+	return &Pool{
+		seen:      make(map[string]bool),
+		bySubject: make(map[string][]*x509.Certificate),
+		bySKI:     make(map[string][]*x509.Certificate),
+	}
+}
+
+// AddCert adds c to the pool. Adding the same certificate twice has no
+// effect.
+func (p *Pool) AddCert(c *x509.Certificate) {
+	if c == nil || p.seen[string(c.Raw)] {
+		return
+	}
+	p.seen[string(c.Raw)] = true
+	p.certs = append(p.certs, c)
+	p.bySubject[string(c.RawSubject)] = append(p.bySubject[string(c.RawSubject)], c)
+	if len(c.SubjectKeyId) > 0 {
+		p.bySKI[string(c.SubjectKeyId)] = append(p.bySKI[string(c.SubjectKeyId)], c)
+	}
+}
+
+// Contains returns true if c is in the pool.
+func (p *Pool) Contains(c *x509.Certificate) bool {
+	return p != nil && c != nil && p.seen[string(c.Raw)]
+}
+
+// Len returns the number of certificates in the pool.
+func (p *Pool) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.certs)
+}
+
+// Certificates returns the certificates in the pool in the order they were
+// added.
+func (p *Pool) Certificates() []*x509.Certificate {
+	return p.certs
+}
+
+// AppendFromBytes adds every certificate in data, which is either a bundle of
+// PEM CERTIFICATE blocks or a single DER certificate. It returns the number of
+// certificates added.
+func (p *Pool) AppendFromBytes(data []byte) (int, error) {
+	n := 0
+	sawPEM := false
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		sawPEM = true
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return n, err
+		}
+		p.AddCert(c)
+		n++
+	}
+	if sawPEM {
+		return n, nil
+	}
+	c, err := x509.ParseCertificate(data)
+	if err != nil {
+		return 0, err
+	}
+	p.AddCert(c)
+	return 1, nil
+}
+
+// AppendFromPath adds the certificates found at path. If path is a directory
+// it is walked recursively and files that do not hold certificates are
+// skipped; otherwise path must be a bundle or certificate file. It returns the
+// number of certificates added.
+func (p *Pool) AppendFromPath(path string) (int, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	if !info.IsDir() {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return 0, err
+		}
+		n, err := p.AppendFromBytes(data)
+		if err != nil {
+			return n, fmt.Errorf("%s: %s", path, err)
+		}
+		if n == 0 {
+			return 0, errors.New(path + ": no certificates found")
+		}
+		return n, nil
+	}
+	total := 0
+	err = filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		n, _ := p.AppendFromBytes(data)
+		total += n
+		return nil
+	})
+	return total, err
+}
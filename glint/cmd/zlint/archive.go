@@ -0,0 +1,160 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type archiveKind int
+
+const (
+	notAnArchive archiveKind = iota
+	zipArchive
+	tarArchive
+	tarGzArchive
+)
+
+// kindOfArchive identifies the container format of a corpus archive from its
+// file name.
+func kindOfArchive(name string) archiveKind {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return zipArchive
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return tarGzArchive
+	case strings.HasSuffix(name, ".tar"):
+		return tarArchive
+	}
+	return notAnArchive
+}
+
+func isArchive(name string) bool {
+	return kindOfArchive(name) != notAnArchive
+}
+
+// formatFromName returns the certificate encoding implied by a file name,
+// falling back to inform when the extension says nothing.
+func formatFromName(name string, inform string) string {
+	switch {
+	case strings.HasSuffix(name, ".der"):
+		return "der"
+	case strings.HasSuffix(name, ".pem"):
+		return "pem"
+	}
+	return inform
+}
+
+// An entryHandler processes the contents of one input file or archive entry,
+// identified by id, in the given format. lintBytes is the default one.
+type entryHandler func(id string, data []byte, inform string) bool
+
+// processArchive passes every entry stored in the archive f to handle
+// without extracting it to disk. Each entry is identified by the archive
+// path followed by its path inside the archive, and nested archives are
+// walked recursively.
+func processArchive(f *os.File, inform string, handle entryHandler) {
+	info, err := f.Stat()
+	if err != nil {
+		log.Fatalf("unable to stat archive %s: %s", f.Name(), err)
+	}
+	if err := walkArchive(f.Name(), f, info.Size(), inform, handle); err != nil {
+		log.Errorf("unable to read archive %s: %s", f.Name(), err)
+	}
+}
+
+func walkArchive(archiveID string, r io.ReaderAt, size int64, inform string, handle entryHandler) error {
+	switch kindOfArchive(archiveID) {
+	case zipArchive:
+		return walkZip(archiveID, r, size, inform, handle)
+	case tarArchive:
+		return walkTar(archiveID, io.NewSectionReader(r, 0, size), inform, handle)
+	case tarGzArchive:
+		gz, err := gzip.NewReader(io.NewSectionReader(r, 0, size))
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		return walkTar(archiveID, gz, inform, handle)
+	}
+	return fmt.Errorf("%s is not a supported archive", archiveID)
+}
+
+func walkZip(archiveID string, r io.ReaderAt, size int64, inform string, handle entryHandler) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	for _, entry := range zr.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+		handleArchiveEntry(archiveID+"/"+path.Clean(entry.Name), data, inform, handle)
+	}
+	return nil
+}
+
+func walkTar(archiveID string, r io.Reader, inform string, handle entryHandler) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !hdr.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		handleArchiveEntry(archiveID+"/"+path.Clean(hdr.Name), data, inform, handle)
+	}
+}
+
+func handleArchiveEntry(entryID string, data []byte, inform string, handle entryHandler) {
+	if isArchive(entryID) {
+		if err := walkArchive(entryID, bytes.NewReader(data), int64(len(data)), inform, handle); err != nil {
+			log.Errorf("unable to read nested archive %s: %s", entryID, err)
+		}
+		return
+	}
+	handle(entryID, data, formatFromName(entryID, inform))
+	countLinted()
+}
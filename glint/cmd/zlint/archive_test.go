@@ -0,0 +1,113 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+func buildZip(t *testing.T, files ...archiveFile) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(f.data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func buildTar(t *testing.T, files ...archiveFile) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755})
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(f.data))}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write(f.data)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func buildTarGz(t *testing.T, files ...archiveFile) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write(buildTar(t, files...))
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestWalkArchive(t *testing.T) {
+	certs := []archiveFile{{"a.pem", []byte("a")}, {"dir/b.der", []byte("b")}, {"c", []byte("c")}}
+	cases := []struct {
+		name string
+		data []byte
+		want []string
+	}{
+		{"corpus.zip", buildZip(t, certs...), []string{"corpus.zip/a.pem pem", "corpus.zip/c base64", "corpus.zip/dir/b.der der"}},
+		{"corpus.tar", buildTar(t, certs...), []string{"corpus.tar/a.pem pem", "corpus.tar/c base64", "corpus.tar/dir/b.der der"}},
+		{"corpus.tar.gz", buildTarGz(t, certs...), []string{"corpus.tar.gz/a.pem pem", "corpus.tar.gz/c base64", "corpus.tar.gz/dir/b.der der"}},
+		{"corpus.tgz", buildTarGz(t, certs[0]), []string{"corpus.tgz/a.pem pem"}},
+		{"outer.zip", buildZip(t, certs[0], archiveFile{"inner/nested.tar.gz", buildTarGz(t, certs[1], archiveFile{"deep.zip", buildZip(t, certs[2])})}),
+			[]string{"outer.zip/a.pem pem", "outer.zip/inner/nested.tar.gz/deep.zip/c base64", "outer.zip/inner/nested.tar.gz/dir/b.der der"}},
+	}
+	for _, c := range cases {
+		var got []string
+		before := linted
+		err := walkArchive(c.name, bytes.NewReader(c.data), int64(len(c.data)), "base64", func(id string, data []byte, inform string) bool {
+			got = append(got, id+" "+inform)
+			return true
+		})
+		if err != nil {
+			t.Errorf("%s: %s", c.name, err)
+			continue
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got entries %q, want %q", c.name, got, c.want)
+		}
+		if n := linted - before; n != len(c.want) {
+			t.Errorf("%s: counted %d entries, want %d", c.name, n, len(c.want))
+		}
+	}
+}
+
+func TestWalkArchiveNotAnArchive(t *testing.T) {
+	if err := walkArchive("corpus.rar", bytes.NewReader(nil), 0, "der", nil); err == nil {
+		t.Error("walkArchive accepted a .rar file")
+	}
+}
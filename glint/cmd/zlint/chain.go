@@ -0,0 +1,97 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// loadChainPools loads the -intermediates and -roots pools and makes them the
+// default chain builder, so that lints and recordChains build paths from
+// them. The roots of the -trust-stores snapshots are added to the root pool;
+// a CTL snapshot holds no certificates, so its roots must be given in -roots.
+// It does nothing when none of the flags is set.
+func loadChainPools() {
+	if intermediates == "" && roots == "" && trustStores == "" {
+		return
+	}
+	interPool := loadPool(intermediates)
+	rootPool := loadPool(roots)
+	for _, store := range loadTrustStores() {
+		certs := store.Certificates()
+		if len(certs) == 0 {
+			// A CTL such as authroot.stl lists thumbprints only.
+			log.Warnf("trust store %s adds no root certificates; give its roots with -roots to build chains to them", store.Name)
+		}
+		for _, c := range certs {
+			rootPool.AddCert(c)
+		}
+	}
+	fmt.Printf("Loaded %d intermediates and %d roots\n", interPool.Len(), rootPool.Len())
+	chain.SetDefault(chain.NewBuilder(interPool, rootPool))
+}
+
+// loadPool returns a pool holding the certificates found at each of the
+// comma-separated paths.
+func loadPool(paths string) *chain.Pool {
+	pool := chain.NewPool()
+	for _, path := range strings.Split(paths, ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		if _, err := pool.AppendFromPath(path); err != nil {
+			log.Fatalf("unable to load certificates from %s: %s", path, err)
+		}
+	}
+	return pool
+}
+
+// recordChains builds every candidate path for c from the loaded pools,
+// prints the chosen one and records all of them under certID.
+func recordChains(certID string, c *x509.Certificate) {
+	builder := chain.Default()
+	if builder.Empty() {
+		return
+	}
+	paths := builder.Build(c)
+	chosen := chain.Choose(paths)
+	if chosen == nil {
+		fmt.Printf("No chain for %s\n", certID)
+		return
+	}
+	if chosen.Valid() {
+		fmt.Printf("Chain for %s: %s (valid)\n", certID, chosen)
+	} else {
+		fmt.Printf("Chain for %s: %s (%s)\n", certID, chosen, strings.Join(chosen.ErrorStrings(), "; "))
+	}
+	for i, path := range paths {
+		insertChain(certID, i, path == chosen, path)
+	}
+}
+
+func insertChain(certID string, index int, chosen bool, path *chain.Path) {
+	stmt, err := db.Prepare("INSERT INTO chains(certificate_id, path_index, chosen, anchored, fingerprints, errors) VALUES(?,?,?,?,?,?)")
+	checkDatabaseError(err, certID, "certId")
+	if err != nil {
+		return
+	}
+	_, err = stmt.Exec(certID, index, chosen, path.Anchored, strings.Join(path.Fingerprints(), ","), strings.Join(path.ErrorStrings(), "\n"))
+	checkDatabaseError(err, certID, "certId")
+}
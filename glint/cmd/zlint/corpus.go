@@ -0,0 +1,227 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/lints"
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// batchGCDLintName is the corpus lint "zlint corpus batchgcd" runs.
+const batchGCDLintName = "e_rsa_mod_shares_factor_in_corpus"
+
+// corpusCommand implements "zlint corpus <check>", which runs checks that
+// can only be judged across a whole corpus of certificates.
+func corpusCommand(args []string) {
+	usage := func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s corpus lint [-db file] [-lints names] [path ...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s corpus batchgcd [-db file] [path ...]\n", os.Args[0])
+		os.Exit(2)
+	}
+	if len(args) < 1 {
+		usage()
+	}
+	switch args[0] {
+	case "lint":
+		corpusLint(args[0], args[1:], nil)
+	case "batchgcd":
+		corpusLint(args[0], args[1:], []string{batchGCDLintName})
+	default:
+		usage()
+	}
+}
+
+// corpusLinter feeds the certificates it is handed to a set of corpus lints
+// and records their results.
+type corpusLinter struct {
+	lints   []*lints.CorpusLint
+	source  string
+	count   int
+	skipped int
+	flagged map[string]bool
+}
+
+func newCorpusLinter(names []string) *corpusLinter {
+	cl := &corpusLinter{flagged: make(map[string]bool)}
+	if len(names) == 0 {
+		for name := range lints.CorpusLints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		l, ok := lints.CorpusLints[name]
+		if !ok {
+			log.Fatalf("unknown corpus lint %s", name)
+		}
+		if err := l.Reset(); err != nil {
+			log.Fatalf("unable to initialize %s: %s", name, err)
+		}
+		cl.lints = append(cl.lints, l)
+	}
+	return cl
+}
+
+func (cl *corpusLinter) add(certID string, data []byte, inform string) bool {
+	asn1Data, ok := decodeInput(data, inform, "CERTIFICATE")
+	if !ok {
+		return false
+	}
+	c, err := x509.ParseCertificate(asn1Data)
+	if err != nil {
+		cl.skipped++
+		return true
+	}
+	cl.count++
+	e := &lints.CorpusEntry{ID: certID, Source: cl.source, Cert: c}
+	for _, l := range cl.lints {
+		cl.record(l.Name, l.Observe(e))
+	}
+	return false
+}
+
+func (cl *corpusLinter) finish() {
+	for _, l := range cl.lints {
+		cl.record(l.Name, l.Finish())
+	}
+}
+
+// record stores results in the results database. A later result for the
+// same certificate and lint replaces the earlier one.
+func (cl *corpusLinter) record(lintName string, results []*lints.CorpusResult) {
+	for _, r := range results {
+		key := r.ID + "\x00" + lintName
+		switch r.Status {
+		case lints.Warn, lints.Error, lints.Fatal:
+			if !cl.flagged[key] {
+				fmt.Printf("%s: %s: %s\n", lintName, r.ID, r.Details)
+			}
+			cl.flagged[key] = true
+		default:
+			delete(cl.flagged, key)
+		}
+		stmt, err := db.Prepare("INSERT OR REPLACE INTO results(Certificate_id, lint_name, result) VALUES(?,?,?)")
+		checkDatabaseError(err, r.ID, "certId")
+		if err != nil {
+			continue
+		}
+		_, err = stmt.Exec(r.ID, lintName, r.Status.String())
+		checkDatabaseError(err, r.ID, "certId")
+	}
+}
+
+// corpusLint implements "zlint corpus lint" and "zlint corpus batchgcd". It
+// runs the named corpus lints, or all of them when names is empty, over
+// every certificate under the given paths, or over every certificate in the
+// results database when no path is given, and records a result for each
+// certificate. With -public-only, certificates that chain to none of the
+// -trust-stores roots are NA for corpus lints binding publicly-trusted CAs.
+func corpusLint(command string, args []string, names []string) {
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	dbPath := fs.String("db", "./lint_results.db", "Results database to read certificates from and record findings in")
+	fs.StringVar(&format, "format", format, "One of {pem, der, base64}")
+	lintNames := fs.String("lints", "", "Comma-separated corpus lints to run instead of all of them")
+	fs.StringVar(&trustStores, "trust-stores", trustStores, "Comma-separated name=path root store snapshots (directory, bundle file or authroot.stl)")
+	fs.BoolVar(&publicOnly, "public-only", publicOnly, "Only run requirements binding publicly-trusted CAs on certificates that chain to a -trust-stores root")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s corpus %s [-db file] [-format fmt] [-trust-stores stores [-public-only]] [path ...]\n", os.Args[0], command)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *lintNames != "" {
+		names = strings.Split(*lintNames, ",")
+	}
+	// Corpus lints binding publicly-trusted CAs are scoped by the trust
+	// stores, as in the main command.
+	loadChainPools()
+
+	db, err = sql.Open("sqlite3", *dbPath)
+	if err != nil {
+		log.Fatalf("unable to open database %s: %s", *dbPath, err)
+	}
+	defer db.Close()
+
+	cl := newCorpusLinter(names)
+	for _, l := range cl.lints {
+		insertLint(l.Name, sourceMap[l.Source], l.EffectiveDate)
+	}
+	inform := strings.ToLower(format)
+	if fs.NArg() == 0 {
+		cl.source = *dbPath
+		walkDatabase(cl.add)
+	}
+	for _, path := range fs.Args() {
+		cl.source = path
+		walkPath(path, inform, cl.add)
+	}
+	cl.finish()
+	fmt.Printf("Linted %d certificates, skipped %d unparsable certificates, %d findings\n", cl.count, cl.skipped, len(cl.flagged))
+}
+
+// walkPath passes the certificate file at path, every entry of the archive
+// at path, or every file under the directory at path to handle.
+func walkPath(path string, inform string, handle entryHandler) {
+	isDir, err := isDirectory(path)
+	if err != nil {
+		log.Fatalf("unable to stat %s: %s", path, err)
+	}
+	if isDir {
+		processCertificates(strings.TrimSuffix(path, "/")+"/", inform, handle)
+		return
+	}
+	if !isArchive(path) {
+		// lint identifies a file by its last two path elements, which a
+		// relative file name may not have.
+		if path, err = filepath.Abs(path); err != nil {
+			log.Fatalf("unable to resolve %s: %s", path, err)
+		}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("unable to open %s: %s", path, err)
+	}
+	defer f.Close()
+	if isArchive(path) {
+		processArchive(f, inform, handle)
+	} else {
+		lint(f, formatFromName(path, inform), handle)
+	}
+}
+
+// walkDatabase passes every certificate stored in the Certificates table of
+// the results database to handle, identified by its Certificate_ID.
+func walkDatabase(handle entryHandler) {
+	rows, err := db.Query("SELECT Certificate_ID, Raw_ASN1 FROM Certificates")
+	if err != nil {
+		log.Fatalf("unable to read certificates from the database: %s", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var certID, raw string
+		if err := rows.Scan(&certID, &raw); err != nil {
+			log.Fatalf("unable to read certificates from the database: %s", err)
+		}
+		handle(certID, []byte(raw), "base64")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/truststore"
+)
+
+func TestCorpusLintPublicOnly(t *testing.T) {
+	defer func(stores string, only bool) {
+		trustStores, publicOnly = stores, only
+		truststore.SetDefault(nil, false)
+		chain.SetDefault(chain.NewBuilder(nil, nil))
+	}(trustStores, publicOnly)
+
+	dbPath := filepath.Join(t.TempDir(), "results.db")
+	setup, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"CREATE TABLE lints(lint_name text primary key not null, lint_source text, lint_effective_date text)",
+		"CREATE TABLE results(Certificate_ID text not null, lint_name text not null, result text, primary key (Certificate_ID, lint_name))",
+	} {
+		if _, err := setup.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setup.Close()
+
+	// The Timestamp Authority certificate does not chain to the store root.
+	corpusLint("batchgcd", []string{
+		"-db", dbPath,
+		"-format", "pem",
+		"-trust-stores", "example=../../testlint/testCerts/csSigIssuer.pem",
+		"-public-only",
+		"../../testlint/testCerts/csTSAEkuCodeSigning.pem",
+	}, []string{batchGCDLintName})
+
+	results, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer results.Close()
+	var status string
+	if err := results.QueryRow("SELECT result FROM results WHERE lint_name = ?", batchGCDLintName).Scan(&status); err != nil {
+		t.Fatal(err)
+	}
+	if status != "NA" {
+		t.Errorf("expected NA for a private PKI certificate, got %s", status)
+	}
+}
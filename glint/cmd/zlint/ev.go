@@ -0,0 +1,48 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	log "github.com/sirupsen/logrus"
+)
+
+// loadEVPolicies adds the -ev-policy-oids files to the built-in EV policy
+// OID registry and sets the -ev-detection mode of the EV lints. In "compare"
+// mode, w_ev_policy_detection_mismatch reports the certificates that are EV
+// by only one of the TLS and code-signing OIDs.
+func loadEVPolicies() {
+	mode, err := util.ParseEVDetection(evDetection)
+	if err != nil {
+		log.Fatal(err)
+	}
+	util.SetEVDetection(mode)
+	if evPolicyOIDs == "" {
+		return
+	}
+	registry := util.DefaultEVRegistry()
+	for _, path := range strings.Split(evPolicyOIDs, ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		if err := registry.AppendFromPath(path); err != nil {
+			log.Fatalf("unable to load EV policy OIDs %s: %s", path, err)
+		}
+	}
+	fmt.Printf("Loaded %d EV policy OIDs\n", registry.Len())
+}
@@ -4,6 +4,8 @@ import sqlite3
 conn = sqlite3.connect('lint_results.db')
 db = conn.cursor()
 
+db.execute('DROP TABLE IF EXISTS trust_stores')
+db.execute('DROP TABLE IF EXISTS chains')
 db.execute('DROP TABLE IF EXISTS results')
 db.execute('DROP TABLE IF EXISTS Certificates')
 db.execute('DROP TABLE IF EXISTS lints')
@@ -26,3 +28,19 @@ db.execute('''CREATE TABLE results(
     primary key (Certificate_ID, lint_name),
     foreign key (Certificate_ID) references Certificates,
     foreign key (lint_name) references lints)''')
+
+db.execute('''CREATE TABLE chains(
+    Certificate_ID text not null, 
+    path_index integer not null, 
+    chosen integer, 
+    anchored integer, 
+    fingerprints text, 
+    errors text,
+    primary key (Certificate_ID, path_index),
+    foreign key (Certificate_ID) references Certificates)''')
+
+db.execute('''CREATE TABLE trust_stores(
+    Certificate_ID text not null, 
+    store_name text not null,
+    primary key (Certificate_ID, store_name),
+    foreign key (Certificate_ID) references Certificates)''')
@@ -25,6 +25,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	//"os/exec" Used for making database
 	"database/sql"
@@ -35,6 +36,7 @@ import (
 	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/lints"
 	log "github.com/sirupsen/logrus"
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 )
 
 var ( // flags
@@ -42,6 +44,14 @@ var ( // flags
 	listLintsSchema bool
 	prettyprint     bool
 	format          string
+	inputType       string
+	intermediates   string
+	roots           string
+	trustStores     string
+	publicOnly      bool
+	debianWeakKeys  string
+	evPolicyOIDs    string
+	evDetection     string
 	db              *sql.DB
 	err             error
 )
@@ -50,16 +60,25 @@ func init() {
 	flag.BoolVar(&listLintsJSON, "list-lints-json", false, "Print supported lints in JSON format, one per line")
 	flag.BoolVar(&listLintsSchema, "list-lints-schema", false, "Print supported lints as a ZSchema")
 	flag.StringVar(&format, "format", "der", "One of {pem, der, base64}")
+	flag.StringVar(&inputType, "input-type", "cert", "One of {cert, crl, ocsp}")
 	flag.BoolVar(&prettyprint, "pretty", true, "Pretty-print output")
+	flag.StringVar(&intermediates, "intermediates", "", "Comma-separated directories or bundle files of intermediate CA certificates used to build chains")
+	flag.StringVar(&roots, "roots", "", "Comma-separated directories or bundle files of root CA certificates used to build chains")
+	flag.StringVar(&trustStores, "trust-stores", "", "Comma-separated name=path root store snapshots (directory, bundle file or authroot.stl)")
+	flag.BoolVar(&publicOnly, "public-only", false, "Only run requirements binding publicly-trusted CAs on certificates that chain to a -trust-stores root")
+	flag.StringVar(&debianWeakKeys, "debian-weak-keys", "", "Comma-separated openssl-blacklist files or directories of Debian weak RSA key fingerprints")
+	flag.StringVar(&evPolicyOIDs, "ev-policy-oids", "", "Comma-separated oid,owner,usage files of EV policy OIDs to add to the built-in registry")
+	flag.StringVar(&evDetection, "ev-detection", "codesigning", "Which EV policy OIDs the EV lints accept: one of {codesigning, tls, compare}")
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [flags] file...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] directory or archive (.zip, .tar, .tar.gz)\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s precheck [precheck flags]\n", os.Args[0])
 		flag.PrintDefaults()
 	}
-	flag.Parse()
-	log.SetLevel(log.InfoLevel)
 }
 
 func main() {
+	flag.Parse()
+	log.SetLevel(log.InfoLevel)
 	if listLintsJSON {
 		zlint.EncodeLintDescriptionsToJSON(os.Stdout)
 		return
@@ -77,6 +96,15 @@ func main() {
 		fmt.Printf("})\n")
 		return
 	}
+	loadEVPolicies()
+	if flag.Arg(0) == "precheck" {
+		precheck(flag.Args()[1:])
+		return
+	}
+	if flag.Arg(0) == "corpus" {
+		corpusCommand(flag.Args()[1:])
+		return
+	}
 	//Added logic to check if the results database exists, and if no creates it
 	if _, err := os.Stat("./lint_results.db"); err == nil {
 		db, err = sql.Open("sqlite3", "./lint_results.db") //This file MUST be present in the same directory as the executable
@@ -96,15 +124,31 @@ func main() {
 	} else {
 
 	}
+	switch inputType {
+	case "cert", "crl", "ocsp":
+	default:
+		log.Fatalf("unknown input type %s", inputType)
+	}
+	loadChainPools()
+	loadWeakKeys()
 	insertLints()
 	lintFromDatabase() // Toggle to scan from dataset
 	var inform = strings.ToLower(format)
 	if flag.NArg() < 1 || flag.Arg(0) == "-" {
-		lint(os.Stdin, inform)
+		lint(os.Stdin, inform, lintBytes)
 	} else {
 		//pathToCertificates :="/home/waltersquires1/VXUnderground_Unique_Certs/CS/"//:= flag.Arg(0)
 		pathToCertificates := flag.Arg(0)
-		processCertificates(pathToCertificates, inform)
+		if isArchive(pathToCertificates) {
+			archive, err := os.Open(pathToCertificates)
+			if err != nil {
+				log.Fatalf("unable to open archive %s: %s", pathToCertificates, err)
+			}
+			processArchive(archive, inform, lintBytes)
+			archive.Close()
+		} else {
+			processCertificates(pathToCertificates, inform, lintBytes)
+		}
 
 	}
 }
@@ -151,6 +195,8 @@ func lintFromDatabase() {
 			//insertCertificate(strconv.Itoa(x), c)
 			resultSet := zlint.LintCertificate(c)
 			insertResults(strconv.Itoa(x), resultSet)
+			recordChains(strconv.Itoa(x), c)
+			recordTrust(strconv.Itoa(x), c)
 			//fmt.Println("Done adding Results")
 		}
 		x = x + 1
@@ -158,7 +204,7 @@ func lintFromDatabase() {
 	//fmt.Println("Exit New Fuction")
 }
 
-func lint(inputFile *os.File, inform string) bool {
+func lint(inputFile *os.File, inform string, handle entryHandler) bool {
 	splitPath := strings.Split(inputFile.Name(), "/")
 	certID := splitPath[len(splitPath)-2] + "/" + splitPath[len(splitPath)-1]
 
@@ -166,14 +212,20 @@ func lint(inputFile *os.File, inform string) bool {
 	if err != nil {
 		log.Fatalf("unable to read file %s: %s", inputFile.Name(), err)
 	}
+	return handle(certID, fileBytes, inform)
+}
 
-	var asn1Data []byte
+// decodeInput returns the DER bytes held in fileBytes for the given input
+// format. For PEM input the block must be of type pemType; ok is false when
+// it is not.
+func decodeInput(fileBytes []byte, inform string, pemType string) (asn1Data []byte, ok bool) {
+	var err error
 	switch inform {
 	case "pem":
 		p, _ := pem.Decode(fileBytes)
-		if p == nil || p.Type != "CERTIFICATE" {
+		if p == nil || p.Type != pemType {
 			//log.Fatal("unable to parse PEM")
-			return false
+			return nil, false
 		}
 		asn1Data = p.Bytes
 	case "der":
@@ -186,20 +238,75 @@ func lint(inputFile *os.File, inform string) bool {
 	default:
 		log.Fatalf("unknown input format %s", format)
 	}
+	return asn1Data, true
+}
+
+// lintBytes parses fileBytes as a certificate in the given format and records
+// the certificate and its lint results under certID.
+func lintBytes(certID string, fileBytes []byte, inform string) bool {
+	switch inputType {
+	case "crl":
+		return lintCRLBytes(certID, fileBytes, inform)
+	case "ocsp":
+		return lintOCSPBytes(certID, fileBytes, inform)
+	}
+	asn1Data, ok := decodeInput(fileBytes, inform, "CERTIFICATE")
+	if !ok {
+		return false
+	}
 
 	c, err := x509.ParseCertificate(asn1Data)
 	if err != nil {
-		fmt.Printf("Skip %s\n", inputFile.Name())
+		fmt.Printf("Skip %s\n", certID)
 		return true
 		//log.Fatalf("unable to parse certificate: %s", err)
 	} else {
 		insertCertificate(certID, c)
 		resultSet := zlint.LintCertificate(c)
 		insertResults(certID, resultSet)
+		recordChains(certID, c)
+		recordTrust(certID, c)
 		return false
 	}
 }
 
+// lintCRLBytes parses fileBytes as a CRL in the given format and records its
+// lint results under crlID.
+func lintCRLBytes(crlID string, fileBytes []byte, inform string) bool {
+	asn1Data, ok := decodeInput(fileBytes, inform, "X509 CRL")
+	if !ok {
+		return false
+	}
+
+	crl, err := x509.ParseDERCRL(asn1Data)
+	if err != nil {
+		fmt.Printf("Skip %s\n", crlID)
+		return true
+	}
+	fmt.Printf("Adding CRL: %s\n", crlID)
+	insertResults(crlID, zlint.LintCRL(crl))
+	return false
+}
+
+// lintOCSPBytes parses fileBytes as an OCSP response in the given format and
+// records its lint results under respID. Only successful basic responses can
+// be linted; responder error statuses are skipped.
+func lintOCSPBytes(respID string, fileBytes []byte, inform string) bool {
+	asn1Data, ok := decodeInput(fileBytes, inform, "OCSP RESPONSE")
+	if !ok {
+		return false
+	}
+
+	resp, err := ocsp.ParseResponse(asn1Data)
+	if err != nil {
+		fmt.Printf("Skip %s\n", respID)
+		return true
+	}
+	fmt.Printf("Adding OCSP response: %s\n", respID)
+	insertResults(respID, zlint.LintOCSPResponse(resp))
+	return false
+}
+
 func printResultsToConsole(zlintResult *zlint.ResultSet) {
 	jsonBytes, err := json.Marshal(zlintResult.Results)
 	if err != nil {
@@ -218,13 +325,12 @@ func printResultsToConsole(zlintResult *zlint.ResultSet) {
 	os.Stdout.Sync()
 }
 
-func processCertificates(pathToCertificates string, inform string) {
+func processCertificates(pathToCertificates string, inform string, handle entryHandler) {
 	files, err := ioutil.ReadDir(pathToCertificates)
 	if err != nil {
 		log.Fatal(err)
 
 	}
-	var counter = 0
 	for _, filePath := range files {
 		var inputFile *os.File
 		var err error
@@ -232,30 +338,35 @@ func processCertificates(pathToCertificates string, inform string) {
 		if err != nil {
 			log.Fatalf("unable to open file %s: %s", filePath, err)
 		}
-		var cert_fmt = inform
-		switch {
-		case strings.HasSuffix(filePath.Name(), ".der"):
-			cert_fmt = "der"
-		case strings.HasSuffix(filePath.Name(), ".pem"):
-			cert_fmt = "pem"
-		}
+		var cert_fmt = formatFromName(filePath.Name(), inform)
 		isDir, err := isDirectory(inputFile.Name())
 		if err != nil {
 			log.Fatalf("Could't tell if this was a directory%s: %s", filePath, err)
 		}
 		if isDir {
-			processCertificates(inputFile.Name()+"/", inform)
+			processCertificates(inputFile.Name()+"/", inform, handle)
+		} else if isArchive(filePath.Name()) {
+			processArchive(inputFile, inform, handle)
 		} else {
-			lint(inputFile, cert_fmt)
-			counter++
-			if counter%1000 == 0 {
-				fmt.Println(counter)
-			}
+			lint(inputFile, cert_fmt, handle)
+			countLinted()
 		}
 		inputFile.Close()
 	}
 }
 
+// linted counts the input files and archive entries handled so far.
+var linted = 0
+
+// countLinted records that one more input was handled and prints the running
+// count every 1000 inputs.
+func countLinted() {
+	linted++
+	if linted%1000 == 0 {
+		fmt.Println(linted)
+	}
+}
+
 func isDirectory(path string) (bool, error) {
 	fileInfo, err := os.Stat(path)
 	if err != nil {
@@ -285,24 +396,40 @@ func insertCertificate(certID string, certificate *x509.Certificate) {
 	fmt.Printf("Adding certificate: %s\n", certID)
 }
 
-func insertLints() {
-
-	var sourceMap = map[lints.LintSource]string{
-		0: "UnknownLintSource",
-		1: "CABFBaselineRequirements",
-		2: "MinimumRequirementsForCodeSigningCertificates",
-		3: "RFC5280",
-		4: "RFC5891",
-		5: "ZLint",
-		6: "AWSLabs",
-	}
+// sourceMap names each lint source in the lints table.
+var sourceMap = map[lints.LintSource]string{
+	0: "UnknownLintSource",
+	1: "CABFBaselineRequirements",
+	2: "MinimumRequirementsForCodeSigningCertificates",
+	3: "RFC5280",
+	4: "RFC5891",
+	5: "ZLint",
+	6: "AWSLabs",
+	7: "BRfCSCV20",
+	8: "RFC6960",
+	9: "RFC3161",
+}
 
+func insertLints() {
 	for _, lint := range lints.Lints {
-		stmt, err := db.Prepare("INSERT OR IGNORE INTO lints(lint_name, lint_source, lint_effective_date) VALUES(?,?,?)")
-		checkDatabaseError(err, lint.Name, "lintName")
-		_, err = stmt.Exec(lint.Name, sourceMap[lint.Source], lint.EffectiveDate)
-		checkDatabaseError(err, lint.Name, "lintName")
+		insertLint(lint.Name, sourceMap[lint.Source], lint.EffectiveDate)
+	}
+	for _, lint := range lints.CRLLints {
+		insertLint(lint.Name, sourceMap[lint.Source], lint.EffectiveDate)
+	}
+	for _, lint := range lints.OCSPLints {
+		insertLint(lint.Name, sourceMap[lint.Source], lint.EffectiveDate)
 	}
+	for _, lint := range lints.CorpusLints {
+		insertLint(lint.Name, sourceMap[lint.Source], lint.EffectiveDate)
+	}
+}
+
+func insertLint(name string, source string, effectiveDate time.Time) {
+	stmt, err := db.Prepare("INSERT OR IGNORE INTO lints(lint_name, lint_source, lint_effective_date) VALUES(?,?,?)")
+	checkDatabaseError(err, name, "lintName")
+	_, err = stmt.Exec(name, source, effectiveDate)
+	checkDatabaseError(err, name, "lintName")
 }
 
 func insertResults(certID string, resultSet *zlint.ResultSet) {
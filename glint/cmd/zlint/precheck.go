@@ -0,0 +1,118 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	zlint "github.com/moa-lab/code-signing-certs-lint/tree/main/glint"
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// precheck implements "zlint precheck", which lints a CSR, or the
+// certificate a CA is about to issue, before anything is signed. Results are
+// printed to stdout instead of being recorded in the results database, and
+// the exit status is 1 if any lint returned an error or fatal.
+func precheck(args []string) {
+	fs := flag.NewFlagSet("precheck", flag.ExitOnError)
+	csrPath := fs.String("csr", "", "PKCS#10 CSR to lint (PEM or DER)")
+	templatePath := fs.String("template", "", "Certificate (PEM or DER) whose fields are the issuance template for -csr")
+	issuerPath := fs.String("issuer", "", "Issuing CA certificate (PEM or DER); defaults to the issuer named in -template")
+	tbsPath := fs.String("tbs", "", "DER or PEM encoded TBSCertificate to lint instead of a CSR")
+	fs.BoolVar(&prettyprint, "pretty", prettyprint, "Pretty-print output")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s precheck -csr file [-template file [-issuer file]]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s precheck -tbs file\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	var results *zlint.ResultSet
+	switch {
+	case *tbsPath != "":
+		res, err := zlint.PrecheckTBSCertificate(readDER(*tbsPath, "TBS CERTIFICATE"))
+		if err != nil {
+			log.Fatalf("unable to precheck %s: %s", *tbsPath, err)
+		}
+		results = res
+	case *csrPath != "":
+		csr, err := x509.ParseCertificateRequest(readDER(*csrPath, "CERTIFICATE REQUEST"))
+		if err != nil {
+			log.Fatalf("unable to parse CSR %s: %s", *csrPath, err)
+		}
+		results = zlint.LintCertificateRequest(csr)
+		if *templatePath == "" {
+			break
+		}
+		template := readCertificate(*templatePath)
+		var issuer *x509.Certificate
+		if *issuerPath != "" {
+			issuer = readCertificate(*issuerPath)
+		}
+		certResults, err := zlint.PrecheckCertificateRequest(csr, template, issuer)
+		if err != nil {
+			log.Fatalf("unable to precheck %s: %s", *csrPath, err)
+		}
+		mergeResults(results, certResults)
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	printResultsToConsole(results)
+	if results.ErrorsPresent || results.FatalsPresent {
+		os.Exit(1)
+	}
+}
+
+// readDER returns the contents of path, decoding it first if it holds a PEM
+// block of type pemType.
+func readDER(path string, pemType string) []byte {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatalf("unable to read %s: %s", path, err)
+	}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == pemType {
+			return block.Bytes
+		}
+	}
+	return data
+}
+
+func readCertificate(path string) *x509.Certificate {
+	c, err := x509.ParseCertificate(readDER(path, "CERTIFICATE"))
+	if err != nil {
+		log.Fatalf("unable to parse certificate %s: %s", path, err)
+	}
+	return c
+}
+
+// mergeResults adds the lint results in src to dst. CSR and certificate lint
+// names do not overlap, so nothing is overwritten.
+func mergeResults(dst *zlint.ResultSet, src *zlint.ResultSet) {
+	for name, res := range src.Results {
+		dst.Results[name] = res
+	}
+	dst.NoticesPresent = dst.NoticesPresent || src.NoticesPresent
+	dst.WarningsPresent = dst.WarningsPresent || src.WarningsPresent
+	dst.ErrorsPresent = dst.ErrorsPresent || src.ErrorsPresent
+	dst.FatalsPresent = dst.FatalsPresent || src.FatalsPresent
+}
@@ -0,0 +1,75 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/truststore"
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// loadTrustStores loads the -trust-stores snapshots, given as comma-separated
+// name=path pairs, and makes them the default stores. With -public-only,
+// lints sourced from requirements binding publicly-trusted CAs return NA for
+// certificates that chain to none of them.
+func loadTrustStores() []*truststore.Store {
+	var stores []*truststore.Store
+	for _, spec := range strings.Split(trustStores, ",") {
+		if spec = strings.TrimSpace(spec); spec == "" {
+			continue
+		}
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Fatalf("trust store %s is not of the form name=path", spec)
+		}
+		store, err := truststore.LoadStore(parts[0], parts[1])
+		if err != nil {
+			log.Fatalf("unable to load trust store %s: %s", spec, err)
+		}
+		fmt.Printf("Loaded %d roots from trust store %s\n", store.Len(), store.Name)
+		stores = append(stores, store)
+	}
+	if publicOnly && len(stores) == 0 {
+		log.Fatal("-public-only requires -trust-stores")
+	}
+	truststore.SetDefault(stores, publicOnly)
+	return stores
+}
+
+// recordTrust prints and records the trust stores c chains to. Certificates
+// that chain to none are reported as private PKI.
+func recordTrust(certID string, c *x509.Certificate) {
+	if len(truststore.Default()) == 0 {
+		return
+	}
+	names := truststore.TrustedBy(c)
+	if len(names) == 0 {
+		fmt.Printf("Private PKI: %s\n", certID)
+		return
+	}
+	fmt.Printf("Trusted by %s: %s\n", strings.Join(names, ", "), certID)
+	for _, name := range names {
+		stmt, err := db.Prepare("INSERT INTO trust_stores(certificate_id, store_name) VALUES(?,?)")
+		checkDatabaseError(err, certID, "certId")
+		if err != nil {
+			return
+		}
+		_, err = stmt.Exec(certID, name)
+		checkDatabaseError(err, certID, "certId")
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/weakkeys"
+	log "github.com/sirupsen/logrus"
+)
+
+// loadWeakKeys loads the -debian-weak-keys blocklists, given as a
+// comma-separated list of files or directories, and makes them the default
+// blocklist. It does nothing when the flag is not set.
+func loadWeakKeys() {
+	if debianWeakKeys == "" {
+		return
+	}
+	blocklist := weakkeys.NewBlocklist()
+	for _, path := range strings.Split(debianWeakKeys, ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		if err := blocklist.AppendFromPath(path); err != nil {
+			log.Fatalf("unable to load weak key blocklist %s: %s", path, err)
+		}
+	}
+	fmt.Printf("Loaded %d weak key fingerprints\n", blocklist.Len())
+	weakkeys.SetDefault(blocklist)
+}
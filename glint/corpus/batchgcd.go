@@ -0,0 +1,138 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package corpus implements checks that can only be judged across a whole
+// corpus of certificates rather than one certificate at a time.
+package corpus
+
+import (
+	"math/big"
+)
+
+var one = big.NewInt(1)
+
+// productTree returns the levels of the product tree of moduli, from the
+// leaves up to the root holding the product of all of them.
+func productTree(moduli []*big.Int) [][]*big.Int {
+	tree := [][]*big.Int{moduli}
+	for level := moduli; len(level) > 1; {
+		next := make([]*big.Int, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 < len(level) {
+				next[i] = new(big.Int).Mul(level[2*i], level[2*i+1])
+			} else {
+				next[i] = level[2*i]
+			}
+		}
+		tree = append(tree, next)
+		level = next
+	}
+	return tree
+}
+
+// BatchGCD returns, for each modulus, its greatest common divisor with the
+// product of all the other moduli, using Bernstein's product and remainder
+// trees. A result other than 1 means the modulus shares a factor with
+// another one. The moduli must be distinct: a repeated modulus shares all
+// of its factors with its copy.
+func BatchGCD(moduli []*big.Int) []*big.Int {
+	gcds := make([]*big.Int, len(moduli))
+	if len(moduli) == 0 {
+		return gcds
+	}
+	tree := productTree(moduli)
+	// Walk down the tree reducing the product modulo the square of each
+	// node, so that each leaf ends up with P mod N^2.
+	rems := tree[len(tree)-1]
+	for l := len(tree) - 2; l >= 0; l-- {
+		level := tree[l]
+		next := make([]*big.Int, len(level))
+		for i, n := range level {
+			next[i] = new(big.Int).Mod(rems[i/2], new(big.Int).Mul(n, n))
+		}
+		rems = next
+	}
+	for i, n := range moduli {
+		// (P mod N^2) / N = (P / N) mod N, the product of the others mod N.
+		q := new(big.Int).Quo(rems[i], n)
+		gcds[i] = q.GCD(nil, nil, q, n)
+		if gcds[i].Sign() == 0 {
+			gcds[i].Set(n)
+		}
+	}
+	return gcds
+}
+
+// A Finding is a modulus that shares a prime factor with at least one other
+// modulus of the corpus, which makes its private key computable.
+type Finding struct {
+	// Index of the modulus in the input.
+	Index int
+	// Factor is a non-trivial divisor of the modulus, normally one of its
+	// primes.
+	Factor *big.Int
+	// Peers are the indexes of the other moduli sharing a factor with it.
+	Peers []int
+}
+
+// SharedFactors runs BatchGCD over the distinct moduli and returns a Finding
+// for each modulus that shares a factor with another one. Repeated moduli
+// are the same key used more than once and are not findings in themselves;
+// every copy of a factorable modulus gets its own Finding.
+func SharedFactors(moduli []*big.Int) []Finding {
+	var unique []*big.Int
+	copies := make(map[string][]int)
+	for i, n := range moduli {
+		key := string(n.Bytes())
+		if _, ok := copies[key]; !ok {
+			unique = append(unique, n)
+		}
+		copies[key] = append(copies[key], i)
+	}
+
+	gcds := BatchGCD(unique)
+	var weak []int
+	for i, g := range gcds {
+		if g.Cmp(one) != 0 {
+			weak = append(weak, i)
+		}
+	}
+
+	var findings []Finding
+	for _, i := range weak {
+		n := unique[i]
+		factor := gcds[i]
+		var peers []int
+		// Only the moduli that share something are compared pairwise, which
+		// also recovers a prime when every factor of n is shared and the
+		// batch result is n itself.
+		for _, j := range weak {
+			if j == i {
+				continue
+			}
+			g := new(big.Int).GCD(nil, nil, n, unique[j])
+			if g.Cmp(one) == 0 {
+				continue
+			}
+			peers = append(peers, copies[string(unique[j].Bytes())]...)
+			if factor.Cmp(n) == 0 && g.Cmp(n) != 0 {
+				factor = g
+			}
+		}
+		for _, idx := range copies[string(n.Bytes())] {
+			findings = append(findings, Finding{Index: idx, Factor: factor, Peers: peers})
+		}
+	}
+	return findings
+}
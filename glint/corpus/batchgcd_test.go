@@ -0,0 +1,67 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package corpus
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBatchGCD(t *testing.T) {
+	moduli := []*big.Int{
+		big.NewInt(11 * 13),
+		big.NewInt(17 * 19),
+		big.NewInt(13 * 23),
+		big.NewInt(29 * 31),
+		big.NewInt(37 * 41),
+	}
+	expected := []int64{13, 1, 13, 1, 1}
+	for i, g := range BatchGCD(moduli) {
+		if g.Int64() != expected[i] {
+			t.Errorf("modulus %d: expected gcd %d, got %s", i, expected[i], g)
+		}
+	}
+}
+
+func TestSharedFactors(t *testing.T) {
+	moduli := []*big.Int{
+		big.NewInt(11 * 13), // shares 13 with 2 and 11 with 3
+		big.NewInt(17 * 19),
+		big.NewInt(13 * 23),
+		big.NewInt(11 * 29),
+		big.NewInt(17 * 19), // the same key as 1, not a finding
+		big.NewInt(13 * 23), // the same key as 2, a finding like it
+	}
+	findings := SharedFactors(moduli)
+	got := make(map[int]Finding)
+	for _, f := range findings {
+		got[f.Index] = f
+	}
+	for _, i := range []int{0, 2, 3, 5} {
+		f, ok := got[i]
+		if !ok {
+			t.Errorf("expected modulus %d to be factorable", i)
+			continue
+		}
+		if f.Factor.Cmp(big.NewInt(1)) == 0 || f.Factor.Cmp(moduli[i]) == 0 || new(big.Int).Mod(moduli[i], f.Factor).Sign() != 0 {
+			t.Errorf("modulus %d: %s is not a proper factor", i, f.Factor)
+		}
+	}
+	if len(findings) != 4 {
+		t.Errorf("expected 4 findings, got %d", len(findings))
+	}
+	if peers := got[0].Peers; len(peers) != 3 {
+		t.Errorf("expected modulus 0 to have 3 peers, got %v", peers)
+	}
+}
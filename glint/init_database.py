@@ -4,6 +4,8 @@ import sqlite3
 conn = sqlite3.connect('lint_results.db')
 db = conn.cursor()
 
+db.execute('DROP TABLE IF EXISTS trust_stores')
+db.execute('DROP TABLE IF EXISTS chains')
 db.execute('DROP TABLE IF EXISTS results')
 db.execute('DROP TABLE IF EXISTS certificates')
 db.execute('DROP TABLE IF EXISTS lints')
@@ -26,3 +28,19 @@ db.execute('''CREATE TABLE results(
     primary key (certificate_id, lint_name),
     foreign key (certificate_id) references certificates,
     foreign key (lint_name) references lints)''')
+
+db.execute('''CREATE TABLE chains(
+    certificate_id text not null, 
+    path_index integer not null, 
+    chosen integer, 
+    anchored integer, 
+    fingerprints text, 
+    errors text,
+    primary key (certificate_id, path_index),
+    foreign key (certificate_id) references certificates)''')
+
+db.execute('''CREATE TABLE trust_stores(
+    certificate_id text not null, 
+    store_name text not null,
+    primary key (certificate_id, store_name),
+    foreign key (certificate_id) references certificates)''')
@@ -60,20 +60,14 @@ Certificates whose Subject Distinguished Names can be compared as equal accordin
 ************************************************/
 
 import (
+	"bytes"
 	"fmt"
-	"time"
 
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
 	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
 	"github.com/zmap/zcrypto/x509"
 )
 
-type certChain struct {
-	Inter [][]byte `json:"inter"`
-	Root  []byte   `json:"root"`
-	Leaf  []byte   `json:"leaf"`
-	Error string   `json:"error"`
-}
-
 type issuerFieldEmpty struct{}
 
 func (l *issuerFieldEmpty) Initialize() error {
@@ -85,41 +79,33 @@ func (l *issuerFieldEmpty) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *issuerFieldEmpty) Execute(c *x509.Certificate) *LintResult {
-	//Prior to 2020-9-30 do this
+	if !util.NotAllNameFieldsAreEmpty(&c.Issuer) {
+		return &LintResult{Status: Error}
+	}
+	//Prior to 2020-9-30 a non-empty issuer is enough
 	if util.NameEncodingChange.After(c.NotBefore) {
-		if &c.Issuer != nil && util.NotAllNameFieldsAreEmpty(&c.Issuer) {
-			return &LintResult{Status: Pass}
-		} else {
-			return &LintResult{Status: Error}
-		}
-		//As of 2020-9-30, look at the chain
-	} else {
-		issueTime := c.NotBefore
-		currentTime := time.Date(issueTime.Year(), issueTime.Month(), issueTime.Day()+1, 0, 0, 0, 0, time.UTC)
-		opts := x509.VerifyOptions{
-			CurrentTime:   currentTime,
-			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
-			Intermediates: x509.NewCertPool(),
-		}
-
-		issuedDName := c.Issuer
-		certChains, err, _, _ := c.Verify(opts)
-		if err != nil {
-			fmt.Println("Verification error", err)
-			return &LintResult{Status: Warn} //This shouldn't be returned
+		return &LintResult{Status: Pass}
+	}
+	//As of 2020-9-30, look at every path built from the loaded pools whose
+	//signatures verify. Issuers are found by name or, failing that, by key
+	//identifier, so a mismatch here is an issuer DN re-encoded by the CA.
+	checked := false
+	for _, path := range chain.Default().Build(c) {
+		certs := path.Certificates
+		if len(certs) < 2 || !path.LinksVerified() {
+			continue
 		}
-		for _, chain := range certChains {
-			for _, higher := range chain {
-				fmt.Println(higher.Subject)
-				fmt.Println(issuedDName)
-				//if(higher.Subject != issuedDName) {
-				//	return &LintResult{Status: Error}
-				//}
-				issuedDName = higher.Issuer
+		checked = true
+		for i := 0; i+1 < len(certs); i++ {
+			if !bytes.Equal(certs[i].RawIssuer, certs[i+1].RawSubject) {
+				return &LintResult{Status: Error, Details: fmt.Sprintf("issuer DN of %q is not byte-identical to the subject DN of %q", certs[i].Subject.CommonName, certs[i+1].Subject.CommonName)}
 			}
 		}
-		return &LintResult{Status: Pass}
 	}
+	if !checked {
+		return &LintResult{Status: NE, Details: "no verified certification path could be built from the loaded intermediates and roots"}
+	}
+	return &LintResult{Status: Pass}
 }
 
 func init() {
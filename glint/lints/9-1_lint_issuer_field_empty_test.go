@@ -16,6 +16,8 @@ package lints
 
 import (
 	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
 )
 
 func TestNoIssuerField(t *testing.T) {
@@ -35,3 +37,55 @@ func TestHasIssuerField(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestIssuerFieldNoPath(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrValid.pem"
+	expected := NE
+	out := Lints["e_issuer_field_empty"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestIssuerFieldMatchesPath(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/csSigValid.pem"
+	expected := Pass
+	out := Lints["e_issuer_field_empty"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestIssuerFieldIssuerNotInPool(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/aiaCrit.pem"
+	expected := NE
+	out := Lints["e_issuer_field_empty"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestIssuerFieldUnverifiedKeyIdentifierMatch(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/csAddrValid.pem"
+	expected := NE
+	out := Lints["e_issuer_field_empty"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestIssuerFieldReencoded(t *testing.T) {
+	roots := chain.NewPool()
+	roots.AddCert(ReadCertificate("../testlint/testCerts/csIssuerDNCA.pem"))
+	chain.SetDefault(chain.NewBuilder(nil, roots))
+	defer chain.SetDefault(chain.NewBuilder(nil, nil))
+	inputPath := "../testlint/testCerts/csIssuerDNReencoded.pem"
+	expected := Error
+	out := Lints["e_issuer_field_empty"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -51,7 +51,7 @@ func (l *evJurisdictionMissing) Initialize() error {
 }
 
 func (l *evJurisdictionMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evJurisdictionMissing) Execute(c *x509.Certificate) *LintResult {
@@ -16,6 +16,8 @@ package lints
 
 import (
 	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
 )
 
 func TestEvHasOrg(t *testing.T) {
@@ -35,3 +37,50 @@ func TestEvNoOrg(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestEvCodeSigningHasOrg(t *testing.T) {
+	inputPath := "../testlint/testCerts/csEVCodeSigning.pem"
+	expected := Pass
+	out := Lints["e_ev_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvCodeSigningNoOrg(t *testing.T) {
+	inputPath := "../testlint/testCerts/csEVCodeSigningNoOrg.pem"
+	expected := Error
+	out := Lints["e_ev_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvCodeSigningVendorOID(t *testing.T) {
+	inputPath := "../testlint/testCerts/csEVSectigoCodeSigning.pem"
+	expected := Pass
+	out := Lints["e_ev_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvTLSOnlyNotApplicable(t *testing.T) {
+	inputPath := "../testlint/testCerts/csEVTLSOnly.pem"
+	expected := NA
+	out := Lints["e_ev_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvTLSOnlyTLSDetection(t *testing.T) {
+	util.SetEVDetection(util.EVDetectTLS)
+	defer util.SetEVDetection(util.EVDetectCodeSigning)
+	inputPath := "../testlint/testCerts/csEVTLSOnly.pem"
+	expected := Pass
+	out := Lints["e_ev_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
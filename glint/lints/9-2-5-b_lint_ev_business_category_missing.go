@@ -42,7 +42,7 @@ func (l *evNoBiz) Initialize() error {
 }
 
 func (l *evNoBiz) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evNoBiz) Execute(c *x509.Certificate) *LintResult {
@@ -42,7 +42,7 @@ func (l *evNoBizString) Initialize() error {
 }
 
 func (l *evNoBizString) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evNoBizString) Execute(c *x509.Certificate) *LintResult {
@@ -44,7 +44,7 @@ func (l *evPostalCodeMissing) Initialize() error {
 }
 
 func (l *evPostalCodeMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evPostalCodeMissing) Execute(c *x509.Certificate) *LintResult {
@@ -45,7 +45,7 @@ func (l *evCityOrTownMissing) Initialize() error {
 }
 
 func (l *evCityOrTownMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evCityOrTownMissing) Execute(c *x509.Certificate) *LintResult {
@@ -45,7 +45,7 @@ func (l *evNumberAndStreetMissing) Initialize() error {
 }
 
 func (l *evNumberAndStreetMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evNumberAndStreetMissing) Execute(c *x509.Certificate) *LintResult {
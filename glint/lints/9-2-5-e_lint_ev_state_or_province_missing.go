@@ -45,7 +45,7 @@ func (l *evStateOrProvinceMissing) Initialize() error {
 }
 
 func (l *evStateOrProvinceMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
 }
 
 func (l *evStateOrProvinceMissing) Execute(c *x509.Certificate) *LintResult {
@@ -35,3 +35,12 @@ func TestSubCertValidTimeGood(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSubCertValidTimeCodeSigningAndTimestamping(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAEkuCodeSigning.pem"
+	expected := Error
+	out := Lints["e_sub_cert_valid_time_longer_than_39_months"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
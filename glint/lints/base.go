@@ -17,6 +17,7 @@ package lints
 import (
 	"time"
 
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/truststore"
 	"github.com/zmap/zcrypto/x509"
 )
 
@@ -54,8 +55,20 @@ const (
 	ZLint
 	AWSLabs
 	BRfCSCV20
+	RFC6960
+	RFC3161
 )
 
+// BindsPubliclyTrusted returns true if lints from this source are requirements
+// that only bind publicly-trusted CAs.
+func (s LintSource) BindsPubliclyTrusted() bool {
+	switch s {
+	case CABFBaselineRequirements, MinimumRequirementsForCodeSigningCertificates, BRfCSCV20:
+		return true
+	}
+	return false
+}
+
 // A Lint struct represents a single lint, e.g.
 // "e_basic_constraints_not_critical". It contains an implementation of LintInterface.
 type Lint struct {
@@ -95,13 +108,19 @@ func (l *Lint) CheckEffective(c *x509.Certificate) bool {
 
 // Execute runs the lint against a certificate. For lints that are
 // sourced from the CA/B Forum Baseline Requirements, we first determine
-// if they are within the purview of the BRs. See LintInterface for details
-// about the other methods called. The ordering is as follows:
+// if they are within the purview of the BRs, which is the case for every
+// certificate unless scoping to publicly-trusted hierarchies was enabled with
+// truststore.SetDefault. See LintInterface for details about the other
+// methods called. The ordering is as follows:
 //
+// truststore.InScope()
 // CheckApplies()
 // CheckEffective()
 // Execute()
 func (l *Lint) Execute(cert *x509.Certificate) *LintResult {
+	if l.Source.BindsPubliclyTrusted() && !truststore.InScope(cert) {
+		return &LintResult{Status: NA}
+	}
 	if !l.Lint.CheckApplies(cert) {
 		return &LintResult{Status: NA}
 	} else if !l.CheckEffective(cert) {
@@ -0,0 +1,172 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/truststore"
+	"github.com/zmap/zcrypto/x509"
+)
+
+var (
+	// CorpusLints is a map of all known corpus lints by name. Add a
+	// CorpusLint to the map by calling RegisterCorpusLint.
+	CorpusLints = make(map[string]*CorpusLint)
+)
+
+// A CorpusEntry is one certificate of a corpus.
+type CorpusEntry struct {
+	// ID identifies the certificate in the results database.
+	ID string
+	// Source is the file, archive or database the certificate was read from.
+	Source string
+	Cert   *x509.Certificate
+}
+
+// A CorpusResult is a lint result attributed to the corpus entry with the
+// given ID.
+type CorpusResult struct {
+	ID string
+	LintResult
+}
+
+// CorpusLintInterface is implemented by each CorpusLint. Unlike
+// LintInterface, a corpus lint sees the certificates of a corpus one after
+// the other and may judge a certificate by the ones it has seen before. It
+// should keep only the compact state it needs, such as key fingerprints or
+// serial numbers, rather than the certificates themselves.
+type CorpusLintInterface interface {
+	// Initialize runs once per-lint when it is registered, and again before
+	// each corpus is linted. It discards the state kept for the previous
+	// corpus.
+	Initialize() error
+
+	// CheckApplies runs once per certificate. If it returns false, the
+	// certificate gets an NA result and is not observed.
+	CheckApplies(c *x509.Certificate) bool
+
+	// Observe is called, in corpus order, for every certificate that the lint
+	// applies to. It returns results for e, or for entries observed earlier
+	// that e changes the outcome of.
+	Observe(e *CorpusEntry) []*CorpusResult
+
+	// Finish is called once after the last certificate and returns the
+	// results that could not be given before the whole corpus was seen.
+	Finish() []*CorpusResult
+}
+
+// A CorpusLint struct represents a single lint run across a corpus of
+// certificates, e.g. "e_serial_number_not_unique_per_issuer". The naming and
+// severity conventions are the same as for Lint.
+type CorpusLint struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Citation    string     `json:"citation,omitempty"`
+	Source      LintSource `json:"-"`
+
+	// CorpusLints automatically return NE for all certificates where
+	// CheckApplies() is true but with NotBefore < EffectiveDate. Such
+	// certificates are not observed. This check is bypassed if EffectiveDate
+	// is zero.
+	EffectiveDate time.Time `json:"-"`
+
+	// The implementation of the lint logic.
+	Lint CorpusLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if c was issued on or after the EffectiveDate.
+// If EffectiveDate is zero, CheckEffective always returns true.
+func (l *CorpusLint) CheckEffective(c *x509.Certificate) bool {
+	if l.EffectiveDate.IsZero() || !l.EffectiveDate.After(c.NotBefore) {
+		return true
+	}
+	return false
+}
+
+// Reset discards the state kept for the previous corpus.
+func (l *CorpusLint) Reset() error {
+	return l.Lint.Initialize()
+}
+
+// Observe feeds one certificate to the lint. The ordering is the same as
+// for Lint.Execute:
+//
+// truststore.InScope()
+// CheckApplies()
+// CheckEffective()
+// Observe()
+//
+// A later result for the same ID replaces an earlier one.
+func (l *CorpusLint) Observe(e *CorpusEntry) []*CorpusResult {
+	if l.Source.BindsPubliclyTrusted() && !truststore.InScope(e.Cert) {
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: NA}}}
+	}
+	if !l.Lint.CheckApplies(e.Cert) {
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: NA}}}
+	} else if !l.CheckEffective(e.Cert) {
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: NE}}}
+	}
+	return l.Lint.Observe(e)
+}
+
+// Finish returns the results pending once the whole corpus has been
+// observed.
+func (l *CorpusLint) Finish() []*CorpusResult {
+	return l.Lint.Finish()
+}
+
+// ExecuteCorpus resets the lint, runs it over entries and returns the final
+// result of each entry by ID.
+func (l *CorpusLint) ExecuteCorpus(entries []*CorpusEntry) (map[string]*LintResult, error) {
+	if err := l.Reset(); err != nil {
+		return nil, err
+	}
+	out := make(map[string]*LintResult, len(entries))
+	record := func(results []*CorpusResult) {
+		for _, r := range results {
+			res := r.LintResult
+			out[r.ID] = &res
+		}
+	}
+	for _, e := range entries {
+		record(l.Observe(e))
+	}
+	record(l.Finish())
+	return out, nil
+}
+
+// RegisterCorpusLint must be called once for each corpus lint to be
+// executed. Duplicate lint names are squashed. Normally, RegisterCorpusLint
+// is called during init().
+func RegisterCorpusLint(l *CorpusLint) {
+	if err := l.Lint.Initialize(); err != nil {
+		panic("could not initialize lint: " + l.Name + ": " + err.Error())
+	}
+	CorpusLints[l.Name] = l
+}
+
+// corpusIssuer identifies the CA that issued c by its name and, when
+// present, its authority key identifier, so that two CAs sharing a name are
+// kept apart.
+func corpusIssuer(c *x509.Certificate) string {
+	return string(c.RawIssuer) + "\x00" + string(c.AuthorityKeyId)
+}
+
+// corpusKey identifies the public key certified by c.
+func corpusKey(c *x509.Certificate) [sha256.Size]byte {
+	return sha256.Sum256(c.RawSubjectPublicKeyInfo)
+}
@@ -0,0 +1,95 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+var (
+	// CRLLints is a map of all known CRL lints by name. Add a CRLLint to the
+	// map by calling RegisterCRLLint.
+	CRLLints = make(map[string]*CRLLint)
+)
+
+// CRLLintInterface is implemented by each CRLLint. It mirrors LintInterface,
+// but operates on a parsed CRL instead of a certificate.
+type CRLLintInterface interface {
+	// Initialize runs once per-lint. It is called during RegisterCRLLint().
+	Initialize() error
+
+	// CheckApplies runs once per CRL. It returns true if the CRLLint should
+	// run on the given CRL. If CheckApplies returns false, the CRLLint result
+	// is automatically set to NA without calling Execute().
+	CheckApplies(crl *pkix.CertificateList) bool
+
+	// Execute() is the body of the lint. It is called for every CRL for which
+	// CheckApplies() returns true.
+	Execute(crl *pkix.CertificateList) *LintResult
+}
+
+// A CRLLint struct represents a single lint run against a CRL, e.g.
+// "e_crl_version_not_2". The naming and severity conventions are the same as
+// for Lint.
+type CRLLint struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Citation    string     `json:"citation,omitempty"`
+	Source      LintSource `json:"-"`
+
+	// CRLLints automatically return NE for all CRLs where CheckApplies() is
+	// true but with thisUpdate < EffectiveDate. This check is bypassed if
+	// EffectiveDate is zero.
+	EffectiveDate time.Time `json:"-"`
+
+	// The implementation of the lint logic.
+	Lint CRLLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if crl was issued on or after the EffectiveDate.
+// If EffectiveDate is zero, CheckEffective always returns true.
+func (l *CRLLint) CheckEffective(crl *pkix.CertificateList) bool {
+	if l.EffectiveDate.IsZero() || !l.EffectiveDate.After(crl.TBSCertList.ThisUpdate) {
+		return true
+	}
+	return false
+}
+
+// Execute runs the lint against a CRL. The ordering is the same as for
+// Lint.Execute:
+//
+// CheckApplies()
+// CheckEffective()
+// Execute()
+func (l *CRLLint) Execute(crl *pkix.CertificateList) *LintResult {
+	if !l.Lint.CheckApplies(crl) {
+		return &LintResult{Status: NA}
+	} else if !l.CheckEffective(crl) {
+		return &LintResult{Status: NE}
+	}
+	return l.Lint.Execute(crl)
+}
+
+// RegisterCRLLint must be called once for each CRL lint to be executed.
+// Duplicate lint names are squashed. Normally, RegisterCRLLint is called
+// during init().
+func RegisterCRLLint(l *CRLLint) {
+	if err := l.Lint.Initialize(); err != nil {
+		panic("could not initialize lint: " + l.Name + ": " + err.Error())
+	}
+	CRLLints[l.Name] = l
+}
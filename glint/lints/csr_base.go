@@ -0,0 +1,99 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+var (
+	// CSRLints is a map of all known CSR lints by name. Add a CSRLint to the
+	// map by calling RegisterCSRLint.
+	CSRLints = make(map[string]*CSRLint)
+)
+
+// CSRLintInterface is implemented by each CSRLint. It mirrors LintInterface,
+// but operates on a PKCS#10 certificate request instead of a certificate, so
+// that problems with the requested key or subject can be caught before the
+// CA builds and signs a certificate.
+type CSRLintInterface interface {
+	// Initialize runs once per-lint. It is called during RegisterCSRLint().
+	Initialize() error
+
+	// CheckApplies runs once per CSR. It returns true if the CSRLint should
+	// run on the given CSR. If CheckApplies returns false, the CSRLint result
+	// is automatically set to NA without calling Execute().
+	CheckApplies(csr *x509.CertificateRequest) bool
+
+	// Execute() is the body of the lint. It is called for every CSR for which
+	// CheckApplies() returns true.
+	Execute(csr *x509.CertificateRequest) *LintResult
+}
+
+// A CSRLint struct represents a single lint run against a CSR, e.g.
+// "e_csr_rsa_mod_less_than_3072_bits". The naming and severity conventions
+// are the same as for Lint.
+type CSRLint struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Citation    string     `json:"citation,omitempty"`
+	Source      LintSource `json:"-"`
+
+	// A CSR carries no date of its own. It is linted ahead of an issuance
+	// happening now, so CSRLints return NE when CheckApplies() is true but
+	// the current time is before EffectiveDate. This check is bypassed if
+	// EffectiveDate is zero.
+	EffectiveDate time.Time `json:"-"`
+
+	// The implementation of the lint logic.
+	Lint CSRLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the current time is on or after the
+// EffectiveDate. If EffectiveDate is zero, CheckEffective always returns
+// true.
+func (l *CSRLint) CheckEffective(csr *x509.CertificateRequest) bool {
+	if l.EffectiveDate.IsZero() || !l.EffectiveDate.After(time.Now()) {
+		return true
+	}
+	return false
+}
+
+// Execute runs the lint against a CSR. The ordering is the same as for
+// Lint.Execute:
+//
+// CheckApplies()
+// CheckEffective()
+// Execute()
+func (l *CSRLint) Execute(csr *x509.CertificateRequest) *LintResult {
+	if !l.Lint.CheckApplies(csr) {
+		return &LintResult{Status: NA}
+	} else if !l.CheckEffective(csr) {
+		return &LintResult{Status: NE}
+	}
+	return l.Lint.Execute(csr)
+}
+
+// RegisterCSRLint must be called once for each CSR lint to be executed.
+// Duplicate lint names are squashed. Normally, RegisterCSRLint is called
+// during init().
+func RegisterCSRLint(l *CSRLint) {
+	if err := l.Lint.Initialize(); err != nil {
+		panic("could not initialize lint: " + l.Name + ": " + err.Error())
+	}
+	CSRLints[l.Name] = l
+}
@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4
+7.1.3.2.1 RSA
+	The CA SHALL use one of the following signature algorithms:
+		• RSASSA‐PKCS1‐v1_5 with SHA‐256, SHA‐384 or SHA‐512
+		• RSASSA‐PSS with SHA‐256, SHA‐384 or SHA‐512
+
+7.1.3.2.2 ECDSA
+	The CA SHALL use one of the following signature algorithms:
+		• ECDSA with SHA‐256, SHA‐384 or SHA‐512
+
+7.1.3.2.3 DSA
+	The CA SHALL use the following signature algorithm:
+		• DSA with SHA‐256
+	In addition, the CA MAY use DSA with SHA-1 if one of the following conditions are met:
+		• It is used within Timestamp Authority Certificate and the date of the notBefore field is not
+	greater than 2022‐04‐30; [...]
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type caSignatureAlgorithmNotSupported struct{}
+
+func (l *caSignatureAlgorithmNotSupported) Initialize() error {
+	return nil
+}
+
+func (l *caSignatureAlgorithmNotSupported) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCACert(c)
+}
+
+func (l *caSignatureAlgorithmNotSupported) Execute(c *x509.Certificate) *LintResult {
+	if _, err := util.CheckSignaturePolicy(c); err != nil {
+		return &LintResult{Status: Error, Details: err.Error()}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ca_signature_algorithm_not_supported",
+		Description:   "Root and Subordinate CA Certificates MUST be signed with an algorithm the BRs permit at their notBefore: SHA-256, SHA-384 or SHA-512, and SHA-1 only before 2016.",
+		Citation:      "BRs: 7.1.3.2.1, 7.1.3.2.2, and 7.1.3.2.3",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &caSignatureAlgorithmNotSupported{},
+	})
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1
+	For signature calculation, the data that is to be signed is encoded
+	using the ASN.1 distinguished encoding rules (DER) [X.690].
+
+ITU-T X.690: 10.1
+	The definite form of length encoding shall be used, encoded in the
+	minimum number of octets.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certBERIndefiniteLength struct{}
+
+func (l *certBERIndefiniteLength) Initialize() error {
+	return nil
+}
+
+func (l *certBERIndefiniteLength) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certBERIndefiniteLength) Execute(c *x509.Certificate) *LintResult {
+	n := 0
+	err := util.WalkCertificateDER(c, func(t util.TLV) {
+		if t.IndefiniteLength {
+			n++
+		}
+	})
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if n > 0 {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("%d elements with indefinite lengths", n)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_ber_indefinite_length",
+		Description:   "Certificates MUST be DER encoded, which does not allow the indefinite form of length.",
+		Citation:      "RFC 5280: 4.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &certBERIndefiniteLength{},
+	})
+}
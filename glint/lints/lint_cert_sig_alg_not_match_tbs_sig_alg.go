@@ -0,0 +1,63 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.1.2
+The signatureAlgorithm field contains the identifier for the
+cryptographic algorithm used by the CA to sign this certificate.
+...
+This field MUST contain the same algorithm identifier as the
+signature field in the sequence tbsCertificate (Section 4.1.2.3).
+************************************************/
+
+import (
+	"bytes"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certSigAlgNotMatchTBSSigAlg struct{}
+
+func (l *certSigAlgNotMatchTBSSigAlg) Initialize() error {
+	return nil
+}
+
+func (l *certSigAlgNotMatchTBSSigAlg) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certSigAlgNotMatchTBSSigAlg) Execute(c *x509.Certificate) *LintResult {
+	tbs, outer, err := util.GetSignatureAlgorithms(c)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if bytes.Equal(tbs.Raw, outer.Raw) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_sig_alg_not_match_tbs_sig_alg",
+		Description:   "The signatureAlgorithm field MUST contain the same algorithm identifier as the signature field in the tbsCertificate.",
+		Citation:      "RFC 5280: 4.1.1.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &certSigAlgNotMatchTBSSigAlg{},
+	})
+}
@@ -0,0 +1,110 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 6.1.3
+The basic path processing actions to be performed for certificate i
+(for all i in [1..n]) are listed below.
+
+   (a)  Verify the basic certificate information.  The certificate
+        MUST satisfy each of the following:
+
+      (1)  The signature on the certificate can be verified using
+           working_public_key_algorithm, the working_public_key, and
+           the working_public_key_parameters.
+************************************************/
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certSignatureInvalid struct{}
+
+func (l *certSignatureInvalid) Initialize() error {
+	return nil
+}
+
+// candidateIssuers returns the certificates whose key may have signed c: the
+// issuers found in the loaded intermediate and root pools and, when c names
+// itself as its issuer, c.
+func candidateIssuers(c *x509.Certificate) []*x509.Certificate {
+	candidates := chain.Default().Issuers(c)
+	if bytes.Equal(c.RawSubject, c.RawIssuer) {
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// keyIdentifierMatches returns true if the authority key identifier of c
+// names the key of issuer. A self-signed certificate without an authority
+// key identifier names its own key.
+func keyIdentifierMatches(c *x509.Certificate, issuer *x509.Certificate) bool {
+	if issuer == c && len(c.AuthorityKeyId) == 0 {
+		return true
+	}
+	return len(c.AuthorityKeyId) > 0 && bytes.Equal(c.AuthorityKeyId, issuer.SubjectKeyId)
+}
+
+func (l *certSignatureInvalid) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certSignatureInvalid) Execute(c *x509.Certificate) *LintResult {
+	candidates := candidateIssuers(c)
+	if len(candidates) == 0 {
+		return &LintResult{Status: NE, Details: "no candidate issuer in the loaded intermediates and roots"}
+	}
+	// A self-issued certificate naming another key in its AKI, such as a CA
+	// key rollover certificate, is signed by a key that is not its own.
+	if len(candidates) == 1 && candidates[0] == c && c.AuthorityKeyId != nil && !bytes.Equal(c.AuthorityKeyId, c.SubjectKeyId) {
+		return &LintResult{Status: NE, Details: "self-issued certificate signed by another key; its issuer is not in the loaded intermediates and roots"}
+	}
+	for _, issuer := range candidates {
+		if issuer.CheckSignature(c.SignatureAlgorithm, c.RawTBSCertificate, c.Signature) == nil {
+			return &LintResult{Status: Pass}
+		}
+	}
+	// Candidates matched only by name may hold another key of a CA re-keyed
+	// under the same name, so the signature is only judged against a
+	// candidate tied to c by its key identifier.
+	tied := false
+	for _, issuer := range candidates {
+		if keyIdentifierMatches(c, issuer) {
+			tied = true
+			break
+		}
+	}
+	if !tied {
+		return &LintResult{Status: NE, Details: "no candidate issuer in the loaded intermediates and roots has the key identifier of the signing key"}
+	}
+	return &LintResult{Status: Error, Details: fmt.Sprintf("signature does not verify against any of %d candidate issuers", len(candidates))}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_signature_invalid",
+		Description:   "The signature on the certificate MUST verify with the public key of its issuer; a signature that does not verify is forged or corrupted.",
+		Citation:      "RFC 5280: 6.1.3",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &certSignatureInvalid{},
+	})
+}
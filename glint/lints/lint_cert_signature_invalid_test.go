@@ -0,0 +1,100 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+)
+
+// withIssuerPool makes csSigIssuer.pem the only root known to the default
+// chain builder for the duration of a test.
+func withIssuerPool(t *testing.T) func() {
+	roots := chain.NewPool()
+	roots.AddCert(ReadCertificate("../testlint/testCerts/csSigIssuer.pem"))
+	chain.SetDefault(chain.NewBuilder(nil, roots))
+	return func() { chain.SetDefault(chain.NewBuilder(nil, nil)) }
+}
+
+func TestCertSignatureValid(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/csSigValid.pem"
+	expected := Pass
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureForged(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/csSigForged.pem"
+	expected := Error
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureCorrupted(t *testing.T) {
+	defer withIssuerPool(t)()
+	inputPath := "../testlint/testCerts/csSigCorrupted.pem"
+	expected := Error
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureSelfSigned(t *testing.T) {
+	inputPath := "../testlint/testCerts/csSigIssuer.pem"
+	expected := Pass
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureNoIssuer(t *testing.T) {
+	inputPath := "../testlint/testCerts/csSigValid.pem"
+	expected := NE
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureRollover(t *testing.T) {
+	inputPath := "../testlint/testCerts/csSigRollover.pem"
+	expected := NE
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertSignatureRekeyedIssuerNoAKI(t *testing.T) {
+	roots := chain.NewPool()
+	roots.AddCert(ReadCertificate("../testlint/testCerts/csSigRekeyedIssuer.pem"))
+	chain.SetDefault(chain.NewBuilder(nil, roots))
+	defer chain.SetDefault(chain.NewBuilder(nil, nil))
+	inputPath := "../testlint/testCerts/csSigNoAKI.pem"
+	expected := NE
+	out := Lints["e_cert_signature_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,81 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1
+	Certificate  ::=  SEQUENCE  {
+		tbsCertificate       TBSCertificate,
+		signatureAlgorithm   AlgorithmIdentifier,
+		signatureValue       BIT STRING  }
+
+	TBSCertificate  ::=  SEQUENCE  {
+		...
+		extensions      [3]  Extensions OPTIONAL
+		                     -- If present, version MUST be v3 --  }
+
+Neither SEQUENCE has room for elements after its last field, although
+encoding/asn1 skips them so that later versions can add fields.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certTrailingData struct{}
+
+func (l *certTrailingData) Initialize() error {
+	return nil
+}
+
+func (l *certTrailingData) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certTrailingData) Execute(c *x509.Certificate) *LintResult {
+	cert, _, err := util.ReadTLV(c.Raw)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	elems, err := util.SequenceElements(cert)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if len(elems) > 3 {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("%d elements after signatureValue", len(elems)-3)}
+	}
+	tbs, err := util.ParseTBSCertificate(c.RawTBSCertificate)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if len(tbs.Trailing) > 0 {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("%d elements after the last TBSCertificate field", len(tbs.Trailing))}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_trailing_data",
+		Description:   "Certificate and TBSCertificate MUST NOT contain elements after their last field.",
+		Citation:      "RFC 5280: 4.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &certTrailingData{},
+	})
+}
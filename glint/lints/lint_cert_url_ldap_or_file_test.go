@@ -0,0 +1,53 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestCertURLLdapOrFileLdap(t *testing.T) {
+	inputPath := "../testlint/testCerts/csURLLdap.pem"
+	expected := Warn
+	out := Lints["w_cert_url_ldap_or_file"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertURLLdapOrFileFile(t *testing.T) {
+	inputPath := "../testlint/testCerts/csURLFile.pem"
+	expected := Warn
+	out := Lints["w_cert_url_ldap_or_file"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertURLLdapOrFileHttps(t *testing.T) {
+	inputPath := "../testlint/testCerts/csURLHttps.pem"
+	expected := Pass
+	out := Lints["w_cert_url_ldap_or_file"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCertURLLdapOrFileValid(t *testing.T) {
+	inputPath := "../testlint/testCerts/csURLValid.pem"
+	expected := Pass
+	out := Lints["w_cert_url_ldap_or_file"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,94 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.13
+	When the HTTP or FTP URI scheme is used, the URI MUST point to a single DER
+	encoded CRL as specified in [RFC2585].
+
+RFC 5280: 4.2.2.1
+	When the id-ad-caIssuers accessMethod is used, at least one instance
+	SHOULD specify an accessLocation that is an HTTP [RFC2616] or LDAP
+	[RFC4516] URI.
+
+RFC 3986: 3.2
+	The authority component is preceded by a double slash ("//") and is
+	terminated by the next slash ("/"), question mark ("?"), or number
+	sign ("#") character, or by the end of the URI.
+
+	authority   = [ userinfo "@" ] host [ ":" port ]
+
+RFC 3986: 3.2.1
+	Use of the format "user:password" in the userinfo field is
+	deprecated.
+******************************************************************************/
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certURLMalformed struct{}
+
+func (l *certURLMalformed) Initialize() error {
+	return nil
+}
+
+func (l *certURLMalformed) CheckApplies(c *x509.Certificate) bool {
+	return len(util.GetCertURLs(c)) > 0
+}
+
+func (l *certURLMalformed) Execute(c *x509.Certificate) *LintResult {
+	for _, u := range util.GetCertURLs(c) {
+		parsed, err := url.Parse(u.URL)
+		if err != nil {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s URL %s cannot be parsed: %s", u.Field, u.URL, err)}
+		}
+		if parsed.Scheme == "" {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s URL %s has no scheme", u.Field, u.URL)}
+		}
+		if util.URLHasUserInfo(u.URL) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s URL %s contains userinfo", u.Field, u.URL)}
+		}
+		host, _ := util.URLHostPort(u.URL)
+		if host == "" {
+			// ldap:/// and file:/// leave the host to local configuration.
+			if scheme := strings.ToLower(parsed.Scheme); scheme == "http" || scheme == "https" {
+				return &LintResult{Status: Error, Details: fmt.Sprintf("%s URL %s has no host", u.Field, u.URL)}
+			}
+			continue
+		}
+		if !util.IsFQDNOrIP(host) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s URL %s has the malformed host %q", u.Field, u.URL, host)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_url_malformed",
+		Description:   "caIssuers, OCSP and cRLDistributionPoints URLs MUST be well-formed URIs with a scheme and, for HTTP, a valid host, and MUST NOT contain userinfo.",
+		Citation:      "RFC 5280: 4.2.1.13, 4.2.2.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &certURLMalformed{},
+	})
+}
@@ -0,0 +1,75 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4
+7.1.2.3.b cRLDistributionPoints
+	This extension MUST be present. It MUST NOT be marked critical, and it MUST
+	contain the HTTP URL of the CA’s CRL service.
+
+7.1.2.3.c authorityInformationAccess
+	This extension MUST be present. It MUST NOT be marked critical.
+
+	It MUST contain the HTTP URL of the Issuing CA’s certificate (accessMethod =
+	1.3.6.1.5.5.7.48.2). If the CA provides OCSP responses, it MUST contain the HTTP
+	URL of the Issuing CA’s OCSP responder (accessMethod = 1.3.6.1.5.5.7.48.1).
+******************************************************************************/
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certURLQuery struct{}
+
+func (l *certURLQuery) Initialize() error {
+	return nil
+}
+
+func (l *certURLQuery) CheckApplies(c *x509.Certificate) bool {
+	return len(util.GetCertURLs(c)) > 0
+}
+
+func (l *certURLQuery) Execute(c *x509.Certificate) *LintResult {
+	for _, u := range util.GetCertURLs(c) {
+		parsed, err := url.Parse(u.URL)
+		if err != nil {
+			continue
+		}
+		if scheme := strings.ToLower(parsed.Scheme); scheme != "http" && scheme != "https" {
+			continue
+		}
+		if parsed.RawQuery != "" || parsed.Fragment != "" || strings.ContainsAny(u.URL, "?#") {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("%s URL %s contains a query or fragment", u.Field, u.URL)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_cert_url_query",
+		Description:   "caIssuers, OCSP and cRLDistributionPoints URLs should not contain a query string or fragment. The URL should locate a static resource.",
+		Citation:      "BRs: 7.1.2.3.b/c",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &certURLQuery{},
+	})
+}
@@ -0,0 +1,63 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.1
+	When extensions are used, as expected in this profile, version MUST be 3
+	(value is 2).
+
+RFC 5280: 4.1.2.9
+	This field MUST only appear if the version is 3 (Section 4.1.2.1).
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type certV1V2ContainsExtensions struct{}
+
+func (l *certV1V2ContainsExtensions) Initialize() error {
+	return nil
+}
+
+func (l *certV1V2ContainsExtensions) CheckApplies(c *x509.Certificate) bool {
+	return c.Version < 3
+}
+
+func (l *certV1V2ContainsExtensions) Execute(c *x509.Certificate) *LintResult {
+	tbs, err := util.ParseTBSCertificate(c.RawTBSCertificate)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if tbs.Extensions != nil {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("version %d certificate has extensions", c.Version)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_cert_v1_v2_contains_extensions",
+		Description:   "Certificates with extensions MUST be version 3.",
+		Citation:      "RFC 5280: 4.1.2.9",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &certV1V2ContainsExtensions{},
+	})
+}
@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.6.1 Reserved Certificate Policy Identifiers
+	The CA/Browser Forum reserved policy OIDs for Code Signing Certificates are
+	2.23.140.1.4.1 and 2.23.140.1.3 (EV).
+
+The policy OIDs of the Baseline Requirements for the Issuance and Management
+of Publicly-Trusted TLS Server Certificates (2.23.140.1.2.x) and of the EV
+Guidelines for TLS (2.23.140.1.1) assert compliance with requirements that do
+not apply to code signing.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type codeSigningCertTLSPolicyOID struct{}
+
+func (l *codeSigningCertTLSPolicyOID) Initialize() error {
+	return nil
+}
+
+func (l *codeSigningCertTLSPolicyOID) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCodeSigningCert(c)
+}
+
+func (l *codeSigningCertTLSPolicyOID) Execute(c *x509.Certificate) *LintResult {
+	for _, oid := range c.PolicyIdentifiers {
+		if util.IsTLSPolicyOID(oid) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("TLS policy OID %s", oid)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_code_signing_cert_tls_policy_oid",
+		Description:   "Code Signing Certificates MUST NOT assert the CA/Browser Forum TLS policy OIDs (2.23.140.1.1, 2.23.140.1.2.x).",
+		Citation:      "BRfCSC v3.4: 7.1.6.1",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &codeSigningCertTLSPolicyOID{},
+	})
+}
@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.2.1
+The authority key identifier extension provides a means of
+identifying the public key corresponding to the private key used to
+sign a CRL.
+...
+Conforming CRL issuers MUST use the key identifier method, and MUST
+include this extension in all CRLs issued.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlAuthorityKeyIdentifierMissing struct{}
+
+func (l *crlAuthorityKeyIdentifierMissing) Initialize() error {
+	return nil
+}
+
+func (l *crlAuthorityKeyIdentifierMissing) CheckApplies(crl *pkix.CertificateList) bool {
+	return true
+}
+
+func (l *crlAuthorityKeyIdentifierMissing) Execute(crl *pkix.CertificateList) *LintResult {
+	if util.IsExtInCRL(crl, util.AuthkeyOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_authority_key_identifier_missing",
+		Description:   "Conforming CRL issuers MUST include the authority key identifier extension in all CRLs issued",
+		Citation:      "RFC 5280: 5.2.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlAuthorityKeyIdentifierMissing{},
+	})
+}
@@ -0,0 +1,76 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.1.2.6
+Revoked certificates are listed by their serial numbers. Certificates
+revoked by the CA are uniquely identified by the certificate serial number.
+The date on which the revocation occurred is specified.
+
+RFC 5280: 5.3.2
+The invalidity date is a non-critical CRL entry extension that provides the
+date on which it is known or suspected that the private key was compromised
+or that the certificate otherwise became invalid. This date may be earlier
+than the revocation date in the CRL entry, which is the date at which the CA
+processed the revocation.
+
+RFC 5280 does not bound either date by thisUpdate, but a revocation the CA
+has not yet processed cannot appear in a CRL it has already issued, and a
+future-dated entry leaves signatures made before that date trusted.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryDateAfterThisUpdate struct{}
+
+func (l *crlEntryDateAfterThisUpdate) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryDateAfterThisUpdate) CheckApplies(crl *pkix.CertificateList) bool {
+	return len(crl.TBSCertList.RevokedCertificates) > 0
+}
+
+func (l *crlEntryDateAfterThisUpdate) Execute(crl *pkix.CertificateList) *LintResult {
+	thisUpdate := crl.TBSCertList.ThisUpdate
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		if entry.RevocationTime.After(thisUpdate) {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("serial %s: revocationDate after thisUpdate", entry.SerialNumber)}
+		}
+		_, invalidity, ok, err := util.GetInvalidityDate(entry)
+		if ok && err == nil && invalidity.After(thisUpdate) {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("serial %s: invalidityDate after thisUpdate", entry.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "w_crl_entry_date_after_this_update",
+		Description:   "CRL entry revocationDate and invalidityDate should not be later than the CRL thisUpdate",
+		Citation:      "RFC 5280: 5.1.2.6, 5.3.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlEntryDateAfterThisUpdate{},
+	})
+}
@@ -0,0 +1,75 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.3.2
+The invalidity date is a non-critical CRL entry extension that
+provides the date on which it is known or suspected that the private
+key was compromised or that the certificate otherwise became invalid.
+This date may be earlier than the revocation date in the CRL entry,
+which is the date at which the CA processed the revocation.
+************************************************/
+
+/************************************************
+Code signing revocation is commonly backdated: relying parties must
+reject signatures made after the key was compromised, so the CA sets
+the revocationDate (or the invalidityDate) to the time of compromise
+rather than to the time it processed the request. Either way the
+invalidityDate should not be later than the revocationDate; if it is,
+the two dates disagree about when signatures stopped being trustworthy.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryInvalidityDateAfterRevocationDate struct{}
+
+func (l *crlEntryInvalidityDateAfterRevocationDate) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryInvalidityDateAfterRevocationDate) CheckApplies(crl *pkix.CertificateList) bool {
+	return util.IsExtInCRLEntries(crl, util.InvalidityDateOID)
+}
+
+func (l *crlEntryInvalidityDateAfterRevocationDate) Execute(crl *pkix.CertificateList) *LintResult {
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		_, invalidity, ok, err := util.GetInvalidityDate(entry)
+		if !ok || err != nil {
+			continue
+		}
+		if invalidity.After(entry.RevocationTime) {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("serial %s", entry.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "w_crl_entry_invalidity_date_after_revocation_date",
+		Description:   "The CRL entry invalidityDate SHOULD NOT be later than the revocationDate",
+		Citation:      "RFC 5280: 5.3.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlEntryInvalidityDateAfterRevocationDate{},
+	})
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.3.2
+The invalidity date is a non-critical CRL entry extension that
+provides the date on which it is known or suspected that the private
+key was compromised or that the certificate otherwise became invalid.
+...
+   id-ce-invalidityDate OBJECT IDENTIFIER ::= { id-ce 24 }
+
+   InvalidityDate ::=  GeneralizedTime
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryInvalidityDateNotGeneralizedTime struct{}
+
+func (l *crlEntryInvalidityDateNotGeneralizedTime) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryInvalidityDateNotGeneralizedTime) CheckApplies(crl *pkix.CertificateList) bool {
+	return util.IsExtInCRLEntries(crl, util.InvalidityDateOID)
+}
+
+func (l *crlEntryInvalidityDateNotGeneralizedTime) Execute(crl *pkix.CertificateList) *LintResult {
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		raw, _, ok, err := util.GetInvalidityDate(entry)
+		if !ok {
+			continue
+		}
+		if err != nil || raw.Class != asn1.ClassUniversal || raw.Tag != asn1.TagGeneralizedTime {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", entry.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_entry_invalidity_date_not_generalized_time",
+		Description:   "The CRL entry invalidityDate MUST be encoded as a GeneralizedTime",
+		Citation:      "RFC 5280: 5.3.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlEntryInvalidityDateNotGeneralizedTime{},
+	})
+}
@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.3.1
+The reasonCode is a non-critical CRL entry extension that identifies
+the reason for the certificate revocation.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryReasonCodeMarkedCritical struct{}
+
+func (l *crlEntryReasonCodeMarkedCritical) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryReasonCodeMarkedCritical) CheckApplies(crl *pkix.CertificateList) bool {
+	return util.IsExtInCRLEntries(crl, util.ReasonCodeOID)
+}
+
+func (l *crlEntryReasonCodeMarkedCritical) Execute(crl *pkix.CertificateList) *LintResult {
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		ext := util.GetExtFromList(entry.Extensions, util.ReasonCodeOID)
+		if ext != nil && ext.Critical {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", entry.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_entry_reason_code_marked_critical",
+		Description:   "The CRL entry reasonCode extension MUST NOT be marked critical",
+		Citation:      "RFC 5280: 5.3.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlEntryReasonCodeMarkedCritical{},
+	})
+}
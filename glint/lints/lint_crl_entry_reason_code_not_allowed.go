@@ -0,0 +1,91 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.3.1
+CRLReason ::= ENUMERATED {
+     unspecified             (0),
+     keyCompromise           (1),
+     cACompromise            (2),
+     affiliationChanged      (3),
+     superseded              (4),
+     cessationOfOperation    (5),
+     certificateHold         (6),
+          -- value 7 is not used
+     removeFromCRL           (8),
+     privilegeWithdrawn      (9),
+     aACompromise           (10) }
+
+RFC 5280: 5.2.4
+removeFromCRL is only meaningful in a delta CRL.
+************************************************/
+
+/************************************************
+BRfCSC: 4.9.13 Circumstances for suspension
+The Repository MUST NOT include entries that indicate that a
+Certificate is suspended, so certificateHold (6) is never allowed.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryReasonCodeNotAllowed struct{}
+
+func (l *crlEntryReasonCodeNotAllowed) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryReasonCodeNotAllowed) CheckApplies(crl *pkix.CertificateList) bool {
+	return util.IsExtInCRLEntries(crl, util.ReasonCodeOID)
+}
+
+func (l *crlEntryReasonCodeNotAllowed) Execute(crl *pkix.CertificateList) *LintResult {
+	delta := util.IsDeltaCRL(crl)
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		code, ok, err := util.GetReasonCode(entry)
+		if !ok {
+			continue
+		}
+		if err != nil {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: %s", entry.SerialNumber, err)}
+		}
+		switch {
+		case code == util.ReasonCertificateHold:
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: certificateHold", entry.SerialNumber)}
+		case code == util.ReasonRemoveFromCRL && !delta:
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: removeFromCRL outside a delta CRL", entry.SerialNumber)}
+		case code < util.ReasonUnspecified || code == 7 || code > util.ReasonAACompromise:
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: unknown reason %d", entry.SerialNumber, code)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_entry_reason_code_not_allowed",
+		Description:   "CRL entry reasonCode MUST be a defined CRLReason other than certificateHold, and removeFromCRL MUST only appear in delta CRLs",
+		Citation:      "BRs: 4.9.13; RFC 5280: 5.3.1",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &crlEntryReasonCodeNotAllowed{},
+	})
+}
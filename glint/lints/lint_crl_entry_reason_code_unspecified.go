@@ -0,0 +1,70 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.3.1
+CRL issuers are strongly encouraged to include meaningful reason codes
+in CRL entries; however, the reason code CRL entry extension SHOULD be
+absent instead of using the unspecified (0) reasonCode value.
+************************************************/
+
+/************************************************
+BRfCSC: 7.2.2
+If present, the reasonCode CRL entry extension MUST NOT contain the
+unspecified (0) value.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlEntryReasonCodeUnspecified struct{}
+
+func (l *crlEntryReasonCodeUnspecified) Initialize() error {
+	return nil
+}
+
+func (l *crlEntryReasonCodeUnspecified) CheckApplies(crl *pkix.CertificateList) bool {
+	return util.IsExtInCRLEntries(crl, util.ReasonCodeOID)
+}
+
+func (l *crlEntryReasonCodeUnspecified) Execute(crl *pkix.CertificateList) *LintResult {
+	for i := range crl.TBSCertList.RevokedCertificates {
+		entry := &crl.TBSCertList.RevokedCertificates[i]
+		code, ok, err := util.GetReasonCode(entry)
+		if !ok || err != nil {
+			continue
+		}
+		if code == util.ReasonUnspecified {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", entry.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_entry_reason_code_unspecified",
+		Description:   "If present, the CRL entry reasonCode MUST NOT be unspecified (0)",
+		Citation:      "BRs: 7.2.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &crlEntryReasonCodeUnspecified{},
+	})
+}
@@ -0,0 +1,55 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.1.2.4 & 5.1.2.5
+thisUpdate indicates the issue date of this CRL. nextUpdate indicates
+the date by which the next CRL will be issued, so it can never precede
+thisUpdate.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlNextUpdateBeforeThisUpdate struct{}
+
+func (l *crlNextUpdateBeforeThisUpdate) Initialize() error {
+	return nil
+}
+
+func (l *crlNextUpdateBeforeThisUpdate) CheckApplies(crl *pkix.CertificateList) bool {
+	return !crl.TBSCertList.NextUpdate.IsZero()
+}
+
+func (l *crlNextUpdateBeforeThisUpdate) Execute(crl *pkix.CertificateList) *LintResult {
+	if crl.TBSCertList.NextUpdate.Before(crl.TBSCertList.ThisUpdate) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_next_update_before_this_update",
+		Description:   "CRL nextUpdate MUST NOT be earlier than thisUpdate",
+		Citation:      "RFC 5280: 5.1.2.5",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlNextUpdateBeforeThisUpdate{},
+	})
+}
@@ -0,0 +1,57 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.1.2.5
+This field indicates the date by which the next CRL will be issued.
+The next CRL could be issued before the indicated date, but it will
+not be issued any later than the indicated date.
+...
+Conforming CRL issuers MUST include the nextUpdate field in all CRLs.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlNextUpdateMissing struct{}
+
+func (l *crlNextUpdateMissing) Initialize() error {
+	return nil
+}
+
+func (l *crlNextUpdateMissing) CheckApplies(crl *pkix.CertificateList) bool {
+	return true
+}
+
+func (l *crlNextUpdateMissing) Execute(crl *pkix.CertificateList) *LintResult {
+	if crl.TBSCertList.NextUpdate.IsZero() {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_next_update_missing",
+		Description:   "Conforming CRL issuers MUST include the nextUpdate field in all CRLs",
+		Citation:      "RFC 5280: 5.1.2.5",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlNextUpdateMissing{},
+	})
+}
@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 4.9.7 CRL issuance frequency
+For the status of Subscriber Certificates, the CA SHALL update and
+reissue CRLs at least once every seven days, and the value of the
+nextUpdate field MUST NOT be more than ten days beyond the value of
+the thisUpdate field.
+
+A CRL does not say whether it covers Subscriber or Subordinate CA
+Certificates, so a longer interval is only a warning here. CRLs over
+twelve months are reported by e_crl_next_update_more_than_twelve_months.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlNextUpdateMoreThanTenDays struct{}
+
+func (l *crlNextUpdateMoreThanTenDays) Initialize() error {
+	return nil
+}
+
+func (l *crlNextUpdateMoreThanTenDays) CheckApplies(crl *pkix.CertificateList) bool {
+	return !crl.TBSCertList.NextUpdate.IsZero()
+}
+
+func (l *crlNextUpdateMoreThanTenDays) Execute(crl *pkix.CertificateList) *LintResult {
+	if crl.TBSCertList.ThisUpdate.AddDate(0, 0, 10).Before(crl.TBSCertList.NextUpdate) {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "w_crl_next_update_more_than_ten_days",
+		Description:   "For Subscriber Certificate status, the value of the CRL nextUpdate field MUST NOT be more than ten days beyond the value of the thisUpdate field",
+		Citation:      "BRs: 4.9.7",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &crlNextUpdateMoreThanTenDays{},
+	})
+}
@@ -0,0 +1,56 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 4.9.7 CRL issuance frequency
+If the CA publishes a CRL, then the CA SHALL update and reissue CRLs
+at least once every twelve months for the status of Subordinate CA
+Certificates, and the value of the nextUpdate field MUST NOT be more
+than twelve months beyond the value of the thisUpdate field.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlNextUpdateMoreThanTwelveMonths struct{}
+
+func (l *crlNextUpdateMoreThanTwelveMonths) Initialize() error {
+	return nil
+}
+
+func (l *crlNextUpdateMoreThanTwelveMonths) CheckApplies(crl *pkix.CertificateList) bool {
+	return !crl.TBSCertList.NextUpdate.IsZero()
+}
+
+func (l *crlNextUpdateMoreThanTwelveMonths) Execute(crl *pkix.CertificateList) *LintResult {
+	if crl.TBSCertList.ThisUpdate.AddDate(0, 12, 0).Before(crl.TBSCertList.NextUpdate) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_next_update_more_than_twelve_months",
+		Description:   "The value of the CRL nextUpdate field MUST NOT be more than twelve months beyond the value of the thisUpdate field",
+		Citation:      "BRs: 4.9.7",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &crlNextUpdateMoreThanTwelveMonths{},
+	})
+}
@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.2.3
+The CRL number is a non-critical CRL extension that conveys a
+monotonically increasing sequence number for a given CRL scope and
+CRL issuer.
+...
+CRL issuers conforming to this profile MUST include this extension in
+all CRLs and MUST mark this extension as non-critical.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlNumberMissing struct{}
+
+func (l *crlNumberMissing) Initialize() error {
+	return nil
+}
+
+func (l *crlNumberMissing) CheckApplies(crl *pkix.CertificateList) bool {
+	return true
+}
+
+func (l *crlNumberMissing) Execute(crl *pkix.CertificateList) *LintResult {
+	if util.IsExtInCRL(crl, util.CRLNumberOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_number_missing",
+		Description:   "CRL issuers MUST include the CRL number extension in all CRLs",
+		Citation:      "RFC 5280: 5.2.3",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &crlNumberMissing{},
+	})
+}
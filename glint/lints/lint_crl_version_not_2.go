@@ -0,0 +1,61 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 5.1.2.1
+This optional field describes the version of the encoded CRL. When
+extensions are used, as required by this profile, this field MUST be
+present and MUST specify version 2 (the integer value is 1).
+************************************************/
+
+/************************************************
+BRfCSC: 7.2.1
+The CRL profile requires version 2 CRLs.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+type crlVersionNot2 struct{}
+
+func (l *crlVersionNot2) Initialize() error {
+	return nil
+}
+
+func (l *crlVersionNot2) CheckApplies(crl *pkix.CertificateList) bool {
+	return true
+}
+
+func (l *crlVersionNot2) Execute(crl *pkix.CertificateList) *LintResult {
+	// The encoded value is zero-based, so v2 is encoded as 1.
+	if crl.TBSCertList.Version != 1 {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCRLLint(&CRLLint{
+		Name:          "e_crl_version_not_2",
+		Description:   "CRLs MUST be version 2 CRLs",
+		Citation:      "BRs: 7.2.1",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &crlVersionNot2{},
+	})
+}
@@ -0,0 +1,73 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+Referenced from: BRfCSC v3.4
+
+6.1.5.2 Code Signing Certificate and Timestamp Authority Key Sizes
+	For Keys corresponding to Subscriber code signing and Timestamp Authority
+	Certificates:
+		• If the Key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.
+
+Pre-issuance counterpart of e_ec_improper_curves, run on the key requested in
+a CSR.
+******************************************************************************/
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type csrEcdsaImproperCurves struct{}
+
+func (l *csrEcdsaImproperCurves) Initialize() error {
+	return nil
+}
+
+func (l *csrEcdsaImproperCurves) CheckApplies(csr *x509.CertificateRequest) bool {
+	return csr.PublicKeyAlgorithm == x509.ECDSA
+}
+
+func (l *csrEcdsaImproperCurves) Execute(csr *x509.CertificateRequest) *LintResult {
+	var theKey *ecdsa.PublicKey
+	switch keyType := csr.PublicKey.(type) {
+	case *x509.AugmentedECDSA:
+		theKey = keyType.Pub
+	case *ecdsa.PublicKey:
+		theKey = keyType
+	default:
+		return &LintResult{Status: Error}
+	}
+	switch theKey.Curve.Params().Name {
+	case "P-256", "P-384", "P-521":
+		return &LintResult{Status: Pass}
+	default:
+		return &LintResult{Status: Error}
+	}
+}
+
+func init() {
+	RegisterCSRLint(&CSRLint{
+		Name:          "e_csr_ec_improper_curves",
+		Description:   "CSR: If the requested key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.",
+		Citation:      "BRs: 6.1.5.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.BRfCSCV21MinCryptoEffectiveDate, // Jan 31, 2017
+		Lint:          &csrEcdsaImproperCurves{},
+	})
+}
@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+Referenced from: BRfCSC v3.4
+
+6.1.5.2 Code Signing Certificate and Timestamp Authority Key Sizes
+	For Keys corresponding to Subscriber code signing and Timestamp Authority
+	Certificates:
+		• If the Key is RSA, then the modulus MUST be at least 3072 bits in length.
+
+Pre-issuance counterpart of e_rsa_mod_less_than_3072_bits, run on the key
+requested in a CSR.
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type csrRsaModSize struct{}
+
+func (l *csrRsaModSize) Initialize() error {
+	return nil
+}
+
+func (l *csrRsaModSize) CheckApplies(csr *x509.CertificateRequest) bool {
+	_, ok := csr.PublicKey.(*rsa.PublicKey)
+	return ok && csr.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *csrRsaModSize) Execute(csr *x509.CertificateRequest) *LintResult {
+	key := csr.PublicKey.(*rsa.PublicKey)
+	if key.N.BitLen() < 3072 {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCSRLint(&CSRLint{
+		Name:          "e_csr_rsa_mod_less_than_3072_bits",
+		Description:   "CSR: If the requested key is RSA, then the modulus MUST be at least 3072 bits in length.",
+		Citation:      "BRs: 6.1.5.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.NoRSA2048Date, // June 1st, 2021
+		Lint:          &csrRsaModSize{},
+	})
+}
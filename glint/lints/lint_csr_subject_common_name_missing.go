@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/***************************************************************
+Referenced from: BRfCSC v3.4
+
+7.1.4.2.2 Subject distinguished name fields - EV and Non-EV Code Signing Certificates
+	Certificate Field: subject:commonName (OID 2.5.4.3)
+	Required/Optional: Required
+	Contents: This field MUST contain the Subject's legal name as verified under BR Section 3.2.
+
+Pre-issuance counterpart of e_subject_common_name_missing, run on the subject
+requested in a CSR.
+***************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type csrCommonNameMissing struct{}
+
+func (l *csrCommonNameMissing) Initialize() error {
+	return nil
+}
+
+func (l *csrCommonNameMissing) CheckApplies(csr *x509.CertificateRequest) bool {
+	return true
+}
+
+func (l *csrCommonNameMissing) Execute(csr *x509.CertificateRequest) *LintResult {
+	if csr.Subject.CommonName != "" {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterCSRLint(&CSRLint{
+		Name:          "e_csr_subject_common_name_missing",
+		Description:   "CSR: commonName is required.",
+		Citation:      "BRs: 7.1.4.2.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &csrCommonNameMissing{},
+	})
+}
@@ -0,0 +1,37 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+)
+
+func TestCSRCommonNameMissing(t *testing.T) {
+	inputPath := "../testlint/testCSRs/csrNoCommonName.pem"
+	expected := Error
+	out := CSRLints["e_csr_subject_common_name_missing"].Execute(ReadCSR(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCSRCommonNamePresent(t *testing.T) {
+	inputPath := "../testlint/testCSRs/csrRSA3072.pem"
+	expected := Pass
+	out := CSRLints["e_csr_subject_common_name_missing"].Execute(ReadCSR(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/**********************************************************************************************************************
+Referenced from: BRfCSC v3.4
+
+Certificate Field: subject:countryName (OID: 2.5.4.6)
+Required/Optional: Required
+Contents:   The subject:countryName MUST contain the two-letter ISO 3166-1 country
+			code associated with the location of the Subject verified under BR Section 3.2.2.3. If a Country
+			is not represented by an official ISO 3166-1 country code, the CA MAY specify the ISO 3166-1
+			user-assigned code of XX indicating that an official ISO 3166-1 alpha-2 code has not been
+			assigned.
+
+Pre-issuance counterpart of e_subject_country_not_iso, run on the subject
+requested in a CSR.
+**********************************************************************************************************************/
+
+import (
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type csrCountryNotIso struct{}
+
+func (l *csrCountryNotIso) Initialize() error {
+	return nil
+}
+
+func (l *csrCountryNotIso) CheckApplies(csr *x509.CertificateRequest) bool {
+	return len(csr.Subject.Country) > 0
+}
+
+func (l *csrCountryNotIso) Execute(csr *x509.CertificateRequest) *LintResult {
+	for _, j := range csr.Subject.Country {
+		if !util.IsISOCountryCode(strings.ToUpper(j)) {
+			return &LintResult{Status: Error}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterCSRLint(&CSRLint{
+		Name:          "e_csr_subject_country_not_iso",
+		Description:   "CSR: subject:countryName MUST contain a two-letter ISO 3166-1 country code",
+		Citation:      "BRs: 7.1.4.2.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &csrCountryNotIso{},
+	})
+}
@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/***************************************************************
+MRfCSC: 9.2.4.a
+Certificate Field: subject:organizationName (OID 2.5.4.10)
+Required/Optional: Required
+Contents: The subject:organizationName field MUST contain either
+the Subject's name or DBA as verified under BR Section 3.2.
+
+Pre-issuance counterpart of e_subject_organization_name_missing, run on
+the subject requested in a CSR.
+***************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type csrOrganizationNameMissing struct{}
+
+func (l *csrOrganizationNameMissing) Initialize() error {
+	return nil
+}
+
+func (l *csrOrganizationNameMissing) CheckApplies(csr *x509.CertificateRequest) bool {
+	// A natural person may be named with givenName and surname instead.
+	return len(csr.Subject.GivenName) == 0 && len(csr.Subject.Surname) == 0
+}
+
+func (l *csrOrganizationNameMissing) Execute(csr *x509.CertificateRequest) *LintResult {
+	if len(csr.Subject.Organization) != 0 {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterCSRLint(&CSRLint{
+		Name:          "e_csr_subject_organization_name_missing",
+		Description:   "CSR: organizationName is required, unless the Subject is a natural person.",
+		Citation:      "MRfCSC: 9.2.4.a",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &csrOrganizationNameMissing{},
+	})
+}
@@ -40,7 +40,7 @@ func (l *ecdsaImproperCurves) Initialize() error {
 }
 
 func (l *ecdsaImproperCurves) CheckApplies(c *x509.Certificate) bool {
-	return c.PublicKeyAlgorithm == x509.ECDSA && util.IsSubscriberCert(c)
+	return c.PublicKeyAlgorithm == x509.ECDSA && util.IsSubscriberCert(c) && !util.IsTimestampCert(c)
 }
 
 func (l *ecdsaImproperCurves) Execute(c *x509.Certificate) *LintResult {
@@ -0,0 +1,76 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4
+7.1.3.2.2 ECDSA
+	The CA SHALL use one of the following signature algorithms:
+		• ECDSA with SHA‐256
+		• ECDSA with SHA‐384
+		• ECDSA with SHA‐512
+
+RFC 5480: 4
+	Minimum  | ECDSA    | Message    | Curves
+	Bits of  | Key Size | Digest     |
+	Security |          | Algorithms |
+	---------+----------+------------+-----------
+	128      | 256      | SHA-256    | secp256r1
+	192      | 384      | SHA-384    | secp384r1
+	256      | 512+     | SHA-512    | secp521r1
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type ecdsaSignatureHashCurveMismatch struct{}
+
+func (l *ecdsaSignatureHashCurveMismatch) Initialize() error {
+	return nil
+}
+
+func (l *ecdsaSignatureHashCurveMismatch) CheckApplies(c *x509.Certificate) bool {
+	return util.GetSignatureCurve(c) != ""
+}
+
+func (l *ecdsaSignatureHashCurveMismatch) Execute(c *x509.Certificate) *LintResult {
+	curve := util.GetSignatureCurve(c)
+	p, ok := util.GetSignaturePolicy(c.SignatureAlgorithm, util.GetAlgorithmRole(c), c.NotBefore)
+	if !ok {
+		// Reported by e_signature_algorithm_not_supported.
+		return &LintResult{Status: NA}
+	}
+	for _, allowed := range p.Curves {
+		if allowed == curve {
+			return &LintResult{Status: Pass}
+		}
+	}
+	return &LintResult{Status: Warn, Details: fmt.Sprintf("%s signature made with a %s key", c.SignatureAlgorithm, curve)}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ecdsa_signature_hash_curve_mismatch",
+		Description:   "ECDSA signatures should use the hash matching the curve of the issuer key: SHA-256 with P-256, SHA-384 with P-384 and SHA-512 with P-521.",
+		Citation:      "BRs: 7.1.3.2.2, RFC 5480: 4",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ecdsaSignatureHashCurveMismatch{},
+	})
+}
@@ -0,0 +1,71 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestECDSASignatureHashCurveMismatchP384SHA256(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgECP384IssuerSHA256.pem"
+	expected := Warn
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestECDSASignatureHashCurveMismatchP384SHA512(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgECP384IssuerSHA512.pem"
+	expected := Warn
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestECDSASignatureHashCurveMismatchP384SHA384(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgECP384IssuerSHA384.pem"
+	expected := Pass
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestECDSASignatureHashCurveMismatchRootP384SHA256(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgECP384RootSHA256.pem"
+	expected := Warn
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestECDSASignatureHashCurveMismatchRootP384SHA384(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgECP384RootSHA384.pem"
+	expected := Pass
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestECDSASignatureHashCurveMismatchRSA(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSubCA2022.pem"
+	expected := NA
+	out := Lints["w_ecdsa_signature_hash_curve_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 9.2.4
+Certificate Fields:
+	Locality (if required): subject:jurisdictionLocalityName
+	(OID: 1.3.6.1.4.1.311.60.2.1.1)
+	State or province (if required): subject:jurisdictionStateOrProvinceName
+	(OID: 1.3.6.1.4.1.311.60.2.1.2)
+	Country: subject:jurisdictionCountryName (OID: 1.3.6.1.4.1.311.60.2.1.3)
+Contents: These fields MUST NOT contain information that is not relevant to
+	the level of the Incorporating Agency or Registration Agency. ...
+	Country information MUST be specified using the applicable ISO country
+	code. State or province or locality information (where applicable) for
+	the Subject’s Jurisdiction of Incorporation or Registration MUST be
+	specified using the full name of the applicable jurisdiction.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evJurisdictionCountryNotISO struct{}
+
+func (l *evJurisdictionCountryNotISO) Initialize() error {
+	return nil
+}
+
+func (l *evJurisdictionCountryNotISO) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEVCert(c) && util.IsSubscriberCert(c) && len(c.Subject.JurisdictionCountry) > 0
+}
+
+func (l *evJurisdictionCountryNotISO) Execute(c *x509.Certificate) *LintResult {
+	for _, country := range c.Subject.JurisdictionCountry {
+		if !util.IsISOCountryCode(country) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%q is not an ISO 3166-1 country code", country)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ev_jurisdiction_country_not_iso",
+		Description:   "The subject:jurisdictionCountryName of an EV code signing certificate MUST contain an ISO 3166-1 country code.",
+		Citation:      "EVGs v1.7.3: 9.2.4",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &evJurisdictionCountryNotISO{},
+	})
+}
@@ -0,0 +1,35 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestEvJurisdictionCountryNotISOBad(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrEVJurisdictionCountryBad.pem"
+	expected := Error
+	out := Lints["e_ev_jurisdiction_country_not_iso"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvJurisdictionCountryNotISOValid(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrEVValid.pem"
+	expected := Pass
+	out := Lints["e_ev_jurisdiction_country_not_iso"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
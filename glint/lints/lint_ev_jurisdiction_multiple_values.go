@@ -0,0 +1,76 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 9.2.4
+Certificate Fields:
+	Locality (if required): subject:jurisdictionLocalityName
+	(OID: 1.3.6.1.4.1.311.60.2.1.1)
+	State or province (if required): subject:jurisdictionStateOrProvinceName
+	(OID: 1.3.6.1.4.1.311.60.2.1.2)
+	Country: subject:jurisdictionCountryName (OID: 1.3.6.1.4.1.311.60.2.1.3)
+Contents: These fields MUST NOT contain information that is not relevant to
+	the level of the Incorporating Agency or Registration Agency. ...
+	Country information MUST be specified using the applicable ISO country
+	code. State or province or locality information (where applicable) for
+	the Subject’s Jurisdiction of Incorporation or Registration MUST be
+	specified using the full name of the applicable jurisdiction.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evJurisdictionMultipleValues struct{}
+
+func (l *evJurisdictionMultipleValues) Initialize() error {
+	return nil
+}
+
+func (l *evJurisdictionMultipleValues) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
+}
+
+func (l *evJurisdictionMultipleValues) Execute(c *x509.Certificate) *LintResult {
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"jurisdictionLocalityName", c.Subject.JurisdictionLocality},
+		{"jurisdictionStateOrProvinceName", c.Subject.JurisdictionProvince},
+		{"jurisdictionCountryName", c.Subject.JurisdictionCountry},
+	} {
+		if len(field.values) > 1 {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("subject contains %d %s attributes", len(field.values), field.name)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ev_jurisdiction_multiple_values",
+		Description:   "The Subject of an EV code signing certificate is incorporated or registered in a single jurisdiction, so each jurisdiction field MUST NOT appear more than once.",
+		Citation:      "EVGs v1.7.3: 9.2.4",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &evJurisdictionMultipleValues{},
+	})
+}
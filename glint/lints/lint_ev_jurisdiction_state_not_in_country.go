@@ -0,0 +1,77 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 9.2.4
+Certificate Fields:
+	Locality (if required): subject:jurisdictionLocalityName
+	(OID: 1.3.6.1.4.1.311.60.2.1.1)
+	State or province (if required): subject:jurisdictionStateOrProvinceName
+	(OID: 1.3.6.1.4.1.311.60.2.1.2)
+	Country: subject:jurisdictionCountryName (OID: 1.3.6.1.4.1.311.60.2.1.3)
+Contents: These fields MUST NOT contain information that is not relevant to
+	the level of the Incorporating Agency or Registration Agency. ...
+	Country information MUST be specified using the applicable ISO country
+	code. State or province or locality information (where applicable) for
+	the Subject’s Jurisdiction of Incorporation or Registration MUST be
+	specified using the full name of the applicable jurisdiction.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evJurisdictionStateNotInCountry struct{}
+
+func (l *evJurisdictionStateNotInCountry) Initialize() error {
+	return nil
+}
+
+func (l *evJurisdictionStateNotInCountry) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsEVCert(c) || !util.IsSubscriberCert(c) || len(c.Subject.JurisdictionProvince) == 0 || len(c.Subject.JurisdictionCountry) != 1 {
+		return false
+	}
+	return len(util.GetSubdivisions(c.Subject.JurisdictionCountry[0])) > 0
+}
+
+func (l *evJurisdictionStateNotInCountry) Execute(c *x509.Certificate) *LintResult {
+	country := c.Subject.JurisdictionCountry[0]
+	for _, province := range c.Subject.JurisdictionProvince {
+		if util.IsSubdivisionName(country, province) {
+			continue
+		}
+		if util.IsSubdivisionOf(country, province) {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("%q is a subdivision code rather than the full name of a subdivision of %s", province, country)}
+		}
+		return &LintResult{Status: Warn, Details: fmt.Sprintf("%q is not an ISO 3166-2 subdivision of %s", province, country)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ev_jurisdiction_state_not_in_country",
+		Description:   "The subject:jurisdictionStateOrProvinceName of an EV code signing certificate should be the full name of a state or province of the country in subject:jurisdictionCountryName.",
+		Citation:      "EVGs v1.7.3: 9.2.4",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &evJurisdictionStateNotInCountry{},
+	})
+}
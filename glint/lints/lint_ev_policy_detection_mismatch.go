@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.6.1 Reserved Certificate Policy Identifiers
+	If the Certificate complies with these Requirements and has been issued
+	and operated in accordance with the CA/Browser Forum Guidelines for the
+	Issuance and Management of Extended Validation Certificates, the CA SHALL
+	include 2.23.140.1.3.
+
+Only reported in the "compare" EV detection mode: an EV Code Signing
+Certificate that asserts only TLS EV policy OIDs, or only code-signing ones,
+is judged differently by the EV lints depending on the mode.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evPolicyDetectionMismatch struct{}
+
+func (l *evPolicyDetectionMismatch) Initialize() error {
+	return nil
+}
+
+func (l *evPolicyDetectionMismatch) CheckApplies(c *x509.Certificate) bool {
+	return util.GetEVDetection() == util.EVDetectCompare && util.IsSubscriberCert(c) && util.IsEVCert(c)
+}
+
+func (l *evPolicyDetectionMismatch) Execute(c *x509.Certificate) *LintResult {
+	tls := util.IsEV(c.PolicyIdentifiers)
+	codeSigning := util.IsEVCodeSigning(c.PolicyIdentifiers)
+	if tls == codeSigning {
+		return &LintResult{Status: Pass}
+	}
+	which := "TLS"
+	if codeSigning {
+		which = "code signing"
+	}
+	return &LintResult{Status: Warn, Details: fmt.Sprintf("only %s EV policy OIDs asserted", which)}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ev_policy_detection_mismatch",
+		Description:   "The certificate is EV by its TLS EV policy OIDs or by its code-signing EV policy OIDs, but not by both.",
+		Citation:      "BRfCSC v3.4: 7.1.6.1",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &evPolicyDetectionMismatch{},
+	})
+}
@@ -0,0 +1,63 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+)
+
+func TestEvPolicyDetectionMismatchTLSOnly(t *testing.T) {
+	util.SetEVDetection(util.EVDetectCompare)
+	defer util.SetEVDetection(util.EVDetectCodeSigning)
+	inputPath := "../testlint/testCerts/csEVTLSOnly.pem"
+	expected := Warn
+	out := Lints["w_ev_policy_detection_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvPolicyDetectionMismatchCodeSigningOnly(t *testing.T) {
+	util.SetEVDetection(util.EVDetectCompare)
+	defer util.SetEVDetection(util.EVDetectCodeSigning)
+	inputPath := "../testlint/testCerts/csEVCodeSigning.pem"
+	expected := Warn
+	out := Lints["w_ev_policy_detection_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvPolicyDetectionMismatchBoth(t *testing.T) {
+	util.SetEVDetection(util.EVDetectCompare)
+	defer util.SetEVDetection(util.EVDetectCodeSigning)
+	inputPath := "../testlint/testCerts/csEVVeriSign.pem"
+	expected := Pass
+	out := Lints["w_ev_policy_detection_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvPolicyDetectionMismatchNotComparing(t *testing.T) {
+	inputPath := "../testlint/testCerts/csEVTLSOnly.pem"
+	expected := NA
+	out := Lints["w_ev_policy_detection_mismatch"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,107 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestEvRegistrationNumberFormatInvalidDelaware(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegInvalidDelaware.pem"
+	expected := Error
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidUK(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegInvalidUK.pem"
+	expected := Error
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidGermany(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegInvalidGermany.pem"
+	expected := Error
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidValidDelaware(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegValidDelaware.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidValidUK(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegValidUK.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidValidUKScotland(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegValidUKScotland.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidValidGermany(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegValidGermany.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidValidGermanyCourt(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegValidGermanyCourt.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidDate(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegDate.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEvRegistrationNumberFormatInvalidUnknownJurisdiction(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRegUnknownJurisdiction.pem"
+	expected := Pass
+	out := Lints["e_ev_registration_number_format_invalid"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
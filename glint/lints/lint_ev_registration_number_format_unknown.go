@@ -0,0 +1,96 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 9.2.5
+9.2.5. Subject Registration Number Field
+Certificate field: Subject:serialNumber (OID: 2.5.4.5)
+EV Guidelines, v. 1.7.3 12
+Required/Optional: Required
+Contents: For Private Organizations, this field MUST contain
+the Registration (or similar) Number assigned to the Subject
+by the Incorporating or Registration Agency in its Jurisdiction
+of Incorporation or Registration, as appropriate. If the
+Jurisdiction of Incorporation or Registration does not provide
+a Registration Number, then the date of Incorporation or
+Registration SHALL be entered into this field in any one of the
+common date formats. For Government Entities that do not have a
+Registration Number or readily verifiable date of creation, the CA
+SHALL enter appropriate language to indicate that the Subject is a
+Government Entity. For Business Entities, the Registration Number
+that was received by the Business Entity upon government registration
+SHALL be entered in this field. For those Business Entities that register
+with an Incorporating Agency or Registration Agency in a jurisdiction
+that does not issue numbers pursuant to government registration, the date of
+the registration SHALL be entered into this field in any one of the common
+date formats. Effective as of 1 October 2020, if the CA has disclosed a
+set of acceptable format or formats for Registration Numbers for the
+applicable Registration Agency or Incorporating Agency, as described in
+Section 11.1.3, the CA MUST ensure, prior to issuance, that the Registration
+Number is valid according to at least one currently disclosed format
+for that applicable Registration Agency or Incorporating agency.
+******************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evRegistrationNumberFormatUnknown struct{}
+
+func (l *evRegistrationNumberFormatUnknown) Initialize() error {
+	return nil
+}
+
+func (l *evRegistrationNumberFormatUnknown) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsEVCert(c) || !util.IsSubscriberCert(c) || strings.TrimSpace(c.Subject.SerialNumber) == "" {
+		return false
+	}
+	// Government Entities without a Registration Number describe themselves
+	// in words instead.
+	return util.GetBusinessCategory(c) != "Government Entity" && len(c.Subject.JurisdictionCountry) > 0
+}
+
+func (l *evRegistrationNumberFormatUnknown) Execute(c *x509.Certificate) *LintResult {
+	province := ""
+	if len(c.Subject.JurisdictionProvince) > 0 {
+		province = c.Subject.JurisdictionProvince[0]
+	}
+	_, known := util.GetRegistrationNumberFormat(c.Subject.JurisdictionCountry[0], province)
+	if known || util.IsRegistrationDate(c.Subject.SerialNumber) {
+		return &LintResult{Status: Pass}
+	}
+	jurisdiction := c.Subject.JurisdictionCountry[0]
+	if province != "" {
+		jurisdiction = province + ", " + jurisdiction
+	}
+	return &LintResult{Status: Warn, Details: fmt.Sprintf("no Registration Number format is known for %s", jurisdiction)}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ev_registration_number_format_unknown",
+		Description:   "The Registration Number in the subject:serialNumber of an EV certificate could not be checked, since no format is known for its jurisdiction.",
+		Citation:      "BRfCSCV20: 9.2.5.d",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.EVRegistrationNumberFormatDate,
+		Lint:          &evRegistrationNumberFormatUnknown{},
+	})
+}
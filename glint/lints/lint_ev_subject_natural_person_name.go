@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 8.5
+	The CA MAY only issue EV Certificates to Applicants that meet the Private
+	Organization, Government Entity, Business Entity and Non-Commercial Entity
+	requirements specified below.
+
+v1.7.3: 9.2.1
+	Certificate Field: subject:organizationName (OID 2.5.4.10)
+	Required/Optional: Required
+	Contents: This field MUST contain the Subject’s full legal organization
+	name as listed in the official records of the Incorporating or
+	Registration Agency in the Subject’s Jurisdiction of Incorporation or
+	Registration or as otherwise verified by the CA as provided herein.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type evSubjectNaturalPersonName struct{}
+
+func (l *evSubjectNaturalPersonName) Initialize() error {
+	return nil
+}
+
+func (l *evSubjectNaturalPersonName) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEVCert(c) && util.IsSubscriberCert(c)
+}
+
+func (l *evSubjectNaturalPersonName) Execute(c *x509.Certificate) *LintResult {
+	if len(c.Subject.GivenName) > 0 || len(c.Subject.Surname) > 0 {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ev_subject_natural_person_name",
+		Description:   "EV certificates are issued to organizations, not natural persons, and should not contain givenName or surname.",
+		Citation:      "BRfCSCV20: 9.2.5.a",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &evSubjectNaturalPersonName{},
+	})
+}
@@ -0,0 +1,65 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.3.a certificatePolicies
+	certificatePolicies:policyQualifiers:qualifier:cPSuri (Optional)
+		HTTP URL for the Subordinate CA's Certification Practice Statement,
+		Relying Party Agreement or other pointer to online information
+		provided by the CA.
+******************************************************************************/
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extCertPolicyCPSURINotHTTP struct{}
+
+func (l *extCertPolicyCPSURINotHTTP) Initialize() error {
+	return nil
+}
+
+func (l *extCertPolicyCPSURINotHTTP) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *extCertPolicyCPSURINotHTTP) Execute(c *x509.Certificate) *LintResult {
+	for _, uris := range c.CPSuri {
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return &LintResult{Status: Error, Details: fmt.Sprintf("CPS URI %q", uri)}
+			}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_cert_policy_cps_uri_not_http",
+		Description:   "The cPSuri policy qualifier of a Subscriber Certificate MUST be an HTTP URL.",
+		Citation:      "BRfCSC v3.4: 7.1.2.3.a",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &extCertPolicyCPSURINotHTTP{},
+	})
+}
@@ -0,0 +1,61 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.4
+	CPSuri ::= IA5String
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extCertPolicyCPSURINotIA5String struct{}
+
+func (l *extCertPolicyCPSURINotIA5String) Initialize() error {
+	return nil
+}
+
+func (l *extCertPolicyCPSURINotIA5String) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *extCertPolicyCPSURINotIA5String) Execute(c *x509.Certificate) *LintResult {
+	qualifiers, err := util.GetPolicyQualifiers(c)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, q := range qualifiers {
+		if q.ID.Equal(util.CpsOID) && (q.Qualifier.Class != asn1.ClassUniversal || q.Qualifier.Tag != asn1.TagIA5String) {
+			return &LintResult{Status: Error}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_cert_policy_cps_uri_not_ia5_string",
+		Description:   "The CPS pointer qualifier of a certificate policy MUST be encoded as an IA5String.",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extCertPolicyCPSURINotIA5String{},
+	})
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.4 (as updated by RFC 6818: 3)
+	An explicitText field includes the textual statement directly in the
+	certificate. The explicitText field is a string with a maximum size of
+	200 characters. Conforming CAs SHOULD use the UTF8String encoding for
+	explicitText. VisibleString or BMPString are acceptable but less
+	preferred alternatives. Conforming CAs MUST NOT encode explicitText as
+	IA5String.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extCertPolicyExplicitTextNotUTF8 struct{}
+
+func (l *extCertPolicyExplicitTextNotUTF8) Initialize() error {
+	return nil
+}
+
+func (l *extCertPolicyExplicitTextNotUTF8) CheckApplies(c *x509.Certificate) bool {
+	for _, texts := range c.ExplicitTexts {
+		if len(texts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *extCertPolicyExplicitTextNotUTF8) Execute(c *x509.Certificate) *LintResult {
+	for _, texts := range c.ExplicitTexts {
+		for _, text := range texts {
+			if text.Tag != asn1.TagUTF8String {
+				return &LintResult{Status: Warn}
+			}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ext_cert_policy_explicit_text_not_utf8",
+		Description:   "Conforming CAs SHOULD use the UTF8String encoding for the explicitText of a userNotice.",
+		Citation:      "RFC 6818: 3",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC6818Date,
+		Lint:          &extCertPolicyExplicitTextNotUTF8{},
+	})
+}
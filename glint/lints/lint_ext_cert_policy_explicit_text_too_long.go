@@ -0,0 +1,81 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.4 (as updated by RFC 6818: 3)
+	An explicitText field includes the textual statement directly in the
+	certificate. The explicitText field is a string with a maximum size of
+	200 characters. Conforming CAs SHOULD use the UTF8String encoding for
+	explicitText. VisibleString or BMPString are acceptable but less
+	preferred alternatives. Conforming CAs MUST NOT encode explicitText as
+	IA5String.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"unicode/utf8"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extCertPolicyExplicitTextTooLong struct{}
+
+func (l *extCertPolicyExplicitTextTooLong) Initialize() error {
+	return nil
+}
+
+func (l *extCertPolicyExplicitTextTooLong) CheckApplies(c *x509.Certificate) bool {
+	for _, texts := range c.ExplicitTexts {
+		if len(texts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *extCertPolicyExplicitTextTooLong) Execute(c *x509.Certificate) *LintResult {
+	for _, texts := range c.ExplicitTexts {
+		for _, text := range texts {
+			if explicitTextLength(text) > 200 {
+				return &LintResult{Status: Error}
+			}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+// explicitTextLength returns the number of characters, not bytes, in text.
+func explicitTextLength(text asn1.RawValue) int {
+	switch text.Tag {
+	case asn1.TagUTF8String:
+		return utf8.RuneCount(text.Bytes)
+	case asn1.TagBMPString:
+		return len(text.Bytes) / 2
+	}
+	return len(text.Bytes)
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_cert_policy_explicit_text_too_long",
+		Description:   "The explicitText of a userNotice is a string with a maximum size of 200 characters.",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &extCertPolicyExplicitTextTooLong{},
+	})
+}
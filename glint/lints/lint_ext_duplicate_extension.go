@@ -0,0 +1,79 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2
+	Each extension in a certificate is designated as either critical or
+	non-critical.  A certificate-using system MUST reject the certificate
+	if it encounters a critical extension it does not recognize or a
+	critical extension that contains information that it cannot process.
+	A non-critical extension MAY be ignored if it is not recognized, but
+	MUST be processed if it is recognized.  The following sections present
+	recommended extensions used within Internet certificates and standard
+	locations for information.  Communities may elect to use additional
+	extensions; however, caution ought to be exercised in adopting any
+	critical extensions in certificates that might prevent use in a
+	general context.
+
+	...
+
+	A certificate MUST NOT include more than one instance of a particular
+	extension.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extDuplicateExtension struct{}
+
+func (l *extDuplicateExtension) Initialize() error {
+	return nil
+}
+
+func (l *extDuplicateExtension) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 1
+}
+
+func (l *extDuplicateExtension) Execute(c *x509.Certificate) *LintResult {
+	var seen, dups []asn1.ObjectIdentifier
+	for _, ext := range c.Extensions {
+		if !util.SliceContainsOID(seen, ext.Id) {
+			seen = append(seen, ext.Id)
+		} else if !util.SliceContainsOID(dups, ext.Id) {
+			dups = append(dups, ext.Id)
+		}
+	}
+	if len(dups) > 0 {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("repeated extensions: %v", dups)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_duplicate_extension",
+		Description:   "A certificate MUST NOT include more than one instance of a particular extension.",
+		Citation:      "RFC 5280: 4.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extDuplicateExtension{},
+	})
+}
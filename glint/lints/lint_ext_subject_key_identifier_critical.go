@@ -0,0 +1,53 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.2
+	Conforming CAs MUST mark this extension as non-critical.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extSkiCritical struct{}
+
+func (l *extSkiCritical) Initialize() error {
+	return nil
+}
+
+func (l *extSkiCritical) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectKeyIdentityOID)
+}
+
+func (l *extSkiCritical) Execute(c *x509.Certificate) *LintResult {
+	if util.GetExtFromCert(c, util.SubjectKeyIdentityOID).Critical {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_subject_key_identifier_critical",
+		Description:   "The subjectKeyIdentifier extension MUST NOT be marked critical.",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extSkiCritical{},
+	})
+}
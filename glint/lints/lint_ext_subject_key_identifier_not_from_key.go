@@ -0,0 +1,65 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.2
+	For end entity certificates, subject key identifiers SHOULD be
+	derived from the public key.  Two common methods for generating key
+	identifiers from the public key are identified above.
+
+RFC 7093: 2
+	Other methods of generating unique numbers are also acceptable, such
+	as the leftmost 160 bits of the SHA-256, SHA-384 or SHA-512 hash of
+	the subjectPublicKey, or the hash of the DER encoding of the
+	SubjectPublicKeyInfo.
+******************************************************************************/
+
+import (
+	"bytes"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extSkiNotFromKey struct{}
+
+func (l *extSkiNotFromKey) Initialize() error {
+	return nil
+}
+
+func (l *extSkiNotFromKey) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && len(c.SubjectKeyId) > 0
+}
+
+func (l *extSkiNotFromKey) Execute(c *x509.Certificate) *LintResult {
+	for _, id := range util.KeyIdentifierCandidates(c) {
+		if bytes.Equal(c.SubjectKeyId, id) {
+			return &LintResult{Status: Pass}
+		}
+	}
+	return &LintResult{Status: Warn}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ext_subject_key_identifier_not_from_key",
+		Description:   "Subscriber Certificate: the subjectKeyIdentifier SHOULD be derived from the public key by one of the methods of RFC 5280 or RFC 7093.",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &extSkiNotFromKey{},
+	})
+}
@@ -0,0 +1,72 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2
+	Each extension in a certificate is designated as either critical or
+	non-critical.  A certificate-using system MUST reject the certificate
+	if it encounters a critical extension it does not recognize or a
+	critical extension that contains information that it cannot process.
+	A non-critical extension MAY be ignored if it is not recognized, but
+	MUST be processed if it is recognized.  The following sections present
+	recommended extensions used within Internet certificates and standard
+	locations for information.  Communities may elect to use additional
+	extensions; however, caution ought to be exercised in adopting any
+	critical extensions in certificates that might prevent use in a
+	general context.
+
+	...
+
+	A certificate MUST NOT include more than one instance of a particular
+	extension.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extUnknownCritical struct{}
+
+func (l *extUnknownCritical) Initialize() error {
+	return nil
+}
+
+func (l *extUnknownCritical) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 0
+}
+
+func (l *extUnknownCritical) Execute(c *x509.Certificate) *LintResult {
+	for _, ext := range c.Extensions {
+		if _, ok := util.GetKnownExtension(ext.Id); ext.Critical && !ok {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("unknown critical extension %s", ext.Id)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_ext_unknown_critical",
+		Description:   "Extensions that relying parties are not expected to recognize should not be marked critical, since a critical extension that is not recognized causes the certificate to be rejected.",
+		Citation:      "RFC 5280: 4.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extUnknownCritical{},
+	})
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1
+	Extension  ::=  SEQUENCE  {
+		extnID      OBJECT IDENTIFIER,
+		critical    BOOLEAN DEFAULT FALSE,
+		extnValue   OCTET STRING
+		            -- contains the DER encoding of an ASN.1 value
+		            -- corresponding to the extension type identified
+		            -- by extnID
+		}
+
+Many extensions, e.g. subjectAltName (RFC 5280: 4.2.1.6), are a
+	SEQUENCE SIZE (1..MAX) OF ...
+which cannot be empty.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extValueEmpty struct{}
+
+func (l *extValueEmpty) Initialize() error {
+	return nil
+}
+
+func (l *extValueEmpty) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 0
+}
+
+func (l *extValueEmpty) Execute(c *x509.Certificate) *LintResult {
+	for _, ext := range c.Extensions {
+		known, _ := util.GetKnownExtension(ext.Id)
+		if len(ext.Value) == 0 || known.NonEmptySequence && util.IsEmptyASN1Sequence(ext.Value) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("extension %s is empty", ext.Id)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_value_empty",
+		Description:   "Extension values MUST NOT be empty, nor be an empty SEQUENCE where the extension syntax requires at least one element.",
+		Citation:      "RFC 5280: 4.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extValueEmpty{},
+	})
+}
@@ -0,0 +1,70 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1
+	Extension  ::=  SEQUENCE  {
+		extnID      OBJECT IDENTIFIER,
+		critical    BOOLEAN DEFAULT FALSE,
+		extnValue   OCTET STRING
+		            -- contains the DER encoding of an ASN.1 value
+		            -- corresponding to the extension type identified
+		            -- by extnID
+		}
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extValueMalformed struct{}
+
+func (l *extValueMalformed) Initialize() error {
+	return nil
+}
+
+func (l *extValueMalformed) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 0
+}
+
+func (l *extValueMalformed) Execute(c *x509.Certificate) *LintResult {
+	for _, ext := range c.Extensions {
+		// Empty values are reported by e_ext_value_empty.
+		if len(ext.Value) == 0 {
+			continue
+		}
+		var v asn1.RawValue
+		if _, err := asn1.Unmarshal(ext.Value, &v); err != nil {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("extension %s: %s", ext.Id, err)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_value_malformed",
+		Description:   "Extension values MUST be the DER encoding of an ASN.1 value.",
+		Citation:      "RFC 5280: 4.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extValueMalformed{},
+	})
+}
@@ -0,0 +1,65 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1
+	Extension  ::=  SEQUENCE  {
+		extnID      OBJECT IDENTIFIER,
+		critical    BOOLEAN DEFAULT FALSE,
+		extnValue   OCTET STRING
+		            -- contains the DER encoding of an ASN.1 value
+		            -- corresponding to the extension type identified
+		            -- by extnID
+		}
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type extValueTrailingData struct{}
+
+func (l *extValueTrailingData) Initialize() error {
+	return nil
+}
+
+func (l *extValueTrailingData) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 0
+}
+
+func (l *extValueTrailingData) Execute(c *x509.Certificate) *LintResult {
+	for _, ext := range c.Extensions {
+		// Values that cannot be read are reported by e_ext_value_malformed.
+		if _, rest, err := util.ReadTLV(ext.Value); err == nil && len(rest) > 0 {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("extension %s has %d bytes after its value", ext.Id, len(rest))}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_ext_value_trailing_data",
+		Description:   "Extension values MUST hold a single ASN.1 value, with no data after it.",
+		Citation:      "RFC 5280: 4.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extValueTrailingData{},
+	})
+}
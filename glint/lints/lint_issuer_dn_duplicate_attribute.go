@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Each attribute type should appear once in a name, apart from those such
+	as organizationalUnitName and domainComponent that are meant to repeat.
+	Software that shows one value per type, such as the publisher shown for
+	signed code, displays only one of the repeated values.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type issuerDNDuplicateAttribute struct{}
+
+func (l *issuerDNDuplicateAttribute) Initialize() error {
+	return nil
+}
+
+func (l *issuerDNDuplicateAttribute) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *issuerDNDuplicateAttribute) Execute(c *x509.Certificate) *LintResult {
+	if dups := util.DuplicateNameAttributes(c.Issuer.ToRDNSequence()); len(dups) > 0 {
+		return &LintResult{Status: Warn, Details: fmt.Sprintf("repeated attribute types: %v", dups)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_issuer_dn_duplicate_attribute",
+		Description:   "Issuer name attribute types other than organizationalUnitName, streetAddress and domainComponent should not appear more than once.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &issuerDNDuplicateAttribute{},
+	})
+}
@@ -0,0 +1,102 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC v3.4:
+6.3.2 Certificate operational periods and key pair usage periods
+	The Timestamp Authority MUST use a new Timestamp Certificate with a new
+	private key no later than every 15 months to minimize the impact to users in
+	the event that a Timestamp Certificate's private key is compromised.
+************************************************/
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type keyUsePeriod struct {
+	notBefore time.Time
+	firstID   string
+	notAfter  time.Time
+	lastID    string
+}
+
+type keyReuseAcrossRenewals struct {
+	// periods maps each public key and subject to the earliest notBefore and
+	// the latest notAfter seen for them, with the certificates carrying them.
+	periods map[[sha256.Size]byte]*keyUsePeriod
+}
+
+func (l *keyReuseAcrossRenewals) Initialize() error {
+	l.periods = make(map[[sha256.Size]byte]*keyUsePeriod)
+	return nil
+}
+
+func (l *keyReuseAcrossRenewals) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c)
+}
+
+func (l *keyReuseAcrossRenewals) Observe(e *CorpusEntry) []*CorpusResult {
+	h := sha256.New()
+	h.Write(e.Cert.RawSubjectPublicKeyInfo)
+	h.Write(e.Cert.RawSubject)
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+
+	p, ok := l.periods[key]
+	if !ok {
+		p = &keyUsePeriod{notBefore: e.Cert.NotBefore, firstID: e.ID, notAfter: e.Cert.NotAfter, lastID: e.ID}
+		l.periods[key] = p
+	}
+	if e.Cert.NotBefore.Before(p.notBefore) {
+		p.notBefore, p.firstID = e.Cert.NotBefore, e.ID
+	}
+	if e.Cert.NotAfter.After(p.notAfter) {
+		p.notAfter, p.lastID = e.Cert.NotAfter, e.ID
+	}
+
+	results := []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	// A single certificate valid for too long is judged by the validity
+	// period lints; only the span across renewals is judged here.
+	if p.firstID != p.lastID && p.notBefore.AddDate(0, 15, 0).Before(p.notAfter) {
+		res := &CorpusResult{ID: p.lastID, LintResult: LintResult{Status: Warn, Details: fmt.Sprintf("key pair in use since %s (%s) for more than 15 months", p.notBefore.Format("2006-01-02"), p.firstID)}}
+		if p.lastID == e.ID {
+			results[0] = res
+		} else {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+func (l *keyReuseAcrossRenewals) Finish() []*CorpusResult {
+	return nil
+}
+
+func init() {
+	RegisterCorpusLint(&CorpusLint{
+		Name:          "w_key_reuse_across_renewals",
+		Description:   "A Timestamp Authority should not renew a Timestamp Certificate private key into new certificates for more than 15 months.",
+		Citation:      "BRfCSC v3.4: 6.3.2",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &keyReuseAcrossRenewals{},
+	})
+}
@@ -0,0 +1,55 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestKeyReuseAcrossRenewals(t *testing.T) {
+	renew1 := "../testlint/testCerts/csCorpusTSARenew1.pem"
+	renew2 := "../testlint/testCerts/csCorpusTSARenew2.pem"
+	renew3 := "../testlint/testCerts/csCorpusTSARenew3.pem"
+	// Observed out of order: the renewal that stretches the key pair usage
+	// period past 15 months is flagged whichever order the corpus is in.
+	out := ExecuteCorpusLint("w_key_reuse_across_renewals", renew3, renew2, renew1)
+	for inputPath, expected := range map[string]LintStatus{renew1: Pass, renew2: Pass, renew3: Warn} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestKeyReuseWithinUsagePeriod(t *testing.T) {
+	renew1 := "../testlint/testCerts/csCorpusTSARenew1.pem"
+	renew2 := "../testlint/testCerts/csCorpusTSARenew2.pem"
+	expected := Pass
+	out := ExecuteCorpusLint("w_key_reuse_across_renewals", renew1, renew2)
+	for _, inputPath := range []string{renew1, renew2} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestKeyReuseAcrossRenewalsCodeSigning(t *testing.T) {
+	renew1 := "../testlint/testCerts/csCorpusRenew1.pem"
+	renew3 := "../testlint/testCerts/csCorpusRenew3.pem"
+	expected := NA
+	out := ExecuteCorpusLint("w_key_reuse_across_renewals", renew1, renew3)
+	for _, inputPath := range []string{renew1, renew3} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC v3.4:
+6.1.1.3 Subscriber Key Pair Generation
+The CA SHALL reject a certificate request if one or more of the following
+conditions are met:
+...
+3. The CA has been made aware of a demonstrated or proven method that exposes
+   the Applicant's Private Key to compromise;
+...
+A Private Key certified for a second, unrelated Subject is held by more than
+one party.
+************************************************/
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type keyHolder struct {
+	subject string
+	id      string
+}
+
+type keyReuseAcrossSubjects struct {
+	// holders maps each public key to the subject and ID of the first
+	// certificate seen for it.
+	holders map[[sha256.Size]byte]keyHolder
+}
+
+func (l *keyReuseAcrossSubjects) Initialize() error {
+	l.holders = make(map[[sha256.Size]byte]keyHolder)
+	return nil
+}
+
+func (l *keyReuseAcrossSubjects) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *keyReuseAcrossSubjects) Observe(e *CorpusEntry) []*CorpusResult {
+	key := corpusKey(e.Cert)
+	first, ok := l.holders[key]
+	if !ok {
+		l.holders[key] = keyHolder{subject: string(e.Cert.RawSubject), id: e.ID}
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	}
+	if first.subject == string(e.Cert.RawSubject) {
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	}
+	return []*CorpusResult{
+		{ID: e.ID, LintResult: LintResult{Status: Warn, Details: fmt.Sprintf("public key also certified for a different subject by %s", first.id)}},
+		{ID: first.id, LintResult: LintResult{Status: Warn, Details: fmt.Sprintf("public key also certified for a different subject by %s", e.ID)}},
+	}
+}
+
+func (l *keyReuseAcrossSubjects) Finish() []*CorpusResult {
+	return nil
+}
+
+func init() {
+	RegisterCorpusLint(&CorpusLint{
+		Name:          "w_key_reuse_across_subjects",
+		Description:   "The public key of a Subscriber Certificate should not be certified for a different Subject, since the Private Key is then held by more than one party.",
+		Citation:      "BRfCSC v3.4: 6.1.1.3",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &keyReuseAcrossSubjects{},
+	})
+}
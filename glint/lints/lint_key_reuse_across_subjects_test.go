@@ -0,0 +1,41 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestKeyReuseAcrossSubjects(t *testing.T) {
+	keyA := "../testlint/testCerts/csCorpusKeyA.pem"
+	keyB := "../testlint/testCerts/csCorpusKeyB.pem"
+	other := "../testlint/testCerts/csCorpusSerial3.pem"
+	out := ExecuteCorpusLint("w_key_reuse_across_subjects", keyA, other, keyB)
+	for inputPath, expected := range map[string]LintStatus{keyA: Warn, keyB: Warn, other: Pass} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestKeyReuseSameSubject(t *testing.T) {
+	renew1 := "../testlint/testCerts/csCorpusRenew1.pem"
+	renew2 := "../testlint/testCerts/csCorpusRenew2.pem"
+	expected := Pass
+	out := ExecuteCorpusLint("w_key_reuse_across_subjects", renew1, renew2)
+	for _, inputPath := range []string{renew1, renew2} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.1 ASN.1 Specification of the OCSP Response
+CertStatus ::= CHOICE {
+    good        [0]     IMPLICIT NULL,
+    revoked     [1]     IMPLICIT RevokedInfo,
+    unknown     [2]     IMPLICIT UnknownInfo }
+
+The parser accepts the three alternatives as independent optional
+fields, so a response carrying none of them, or more than one, still
+decodes. Such a response does not say what the status of the
+certificate is.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspCertStatusNotSingleChoice struct{}
+
+func (l *ocspCertStatusNotSingleChoice) Initialize() error {
+	return nil
+}
+
+func (l *ocspCertStatusNotSingleChoice) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return len(resp.TBSResponseData.Responses) > 0
+}
+
+func (l *ocspCertStatusNotSingleChoice) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for i := range resp.TBSResponseData.Responses {
+		single := &resp.TBSResponseData.Responses[i]
+		if n := util.CountOCSPCertStatus(single); n != 1 {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: %d statuses", single.CertID.SerialNumber, n)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_cert_status_not_single_choice",
+		Description:   "Each OCSP SingleResponse MUST carry exactly one of the good, revoked and unknown certStatus alternatives",
+		Citation:      "RFC 6960: 4.2.1",
+		Source:        RFC6960,
+		EffectiveDate: util.RFC6960Date,
+		Lint:          &ocspCertStatusNotSingleChoice{},
+	})
+}
@@ -0,0 +1,66 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.2.1 Time
+thisUpdate: The most recent time at which the status being indicated
+is known by the responder to have been correct.
+
+nextUpdate: The time at or before which newer information will be
+available about the status of the certificate.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspNextUpdateBeforeThisUpdate struct{}
+
+func (l *ocspNextUpdateBeforeThisUpdate) Initialize() error {
+	return nil
+}
+
+func (l *ocspNextUpdateBeforeThisUpdate) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	for _, single := range resp.TBSResponseData.Responses {
+		if !single.NextUpdate.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ocspNextUpdateBeforeThisUpdate) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for _, single := range resp.TBSResponseData.Responses {
+		if !single.NextUpdate.IsZero() && single.NextUpdate.Before(single.ThisUpdate) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", single.CertID.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_next_update_before_this_update",
+		Description:   "The nextUpdate of an OCSP response MUST NOT be earlier than its thisUpdate",
+		Citation:      "RFC 6960: 4.2.2.1",
+		Source:        RFC6960,
+		EffectiveDate: util.RFC6960Date,
+		Lint:          &ocspNextUpdateBeforeThisUpdate{},
+	})
+}
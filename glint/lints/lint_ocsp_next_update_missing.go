@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 4.9.10 On-line revocation checking requirements
+[...] OCSP responses from this service MUST have a maximum expiration
+time of ten days.
+
+RFC 6960: 4.2.2.1
+Responses whose nextUpdate value is earlier than the local system time
+value SHOULD be considered unreliable. [...] If nextUpdate is not set,
+the responder is indicating that newer revocation information is
+available all the time.
+
+A response without nextUpdate never expires, so it cannot meet the
+ten day maximum.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspNextUpdateMissing struct{}
+
+func (l *ocspNextUpdateMissing) Initialize() error {
+	return nil
+}
+
+func (l *ocspNextUpdateMissing) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return len(resp.TBSResponseData.Responses) > 0
+}
+
+func (l *ocspNextUpdateMissing) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for _, single := range resp.TBSResponseData.Responses {
+		if single.NextUpdate.IsZero() {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", single.CertID.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_next_update_missing",
+		Description:   "OCSP responses MUST include nextUpdate, since they MUST expire within ten days",
+		Citation:      "BRs: 4.9.10",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ocspNextUpdateMissing{},
+	})
+}
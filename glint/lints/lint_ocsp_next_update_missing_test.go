@@ -0,0 +1,37 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+)
+
+func TestOCSPNextUpdateMissing(t *testing.T) {
+	inputPath := "../testlint/testOCSP/ocspNextUpdateMissing.pem"
+	expected := Error
+	out := OCSPLints["e_ocsp_next_update_missing"].Execute(ReadOCSPResponse(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestOCSPNextUpdatePresent(t *testing.T) {
+	inputPath := "../testlint/testOCSP/ocspValid.pem"
+	expected := Pass
+	out := OCSPLints["e_ocsp_next_update_missing"].Execute(ReadOCSPResponse(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
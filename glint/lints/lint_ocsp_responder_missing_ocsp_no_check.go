@@ -0,0 +1,61 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 4.9.9 On-line revocation/status checking availability
+OCSP responses MUST either:
+	1. Be signed by the CA that issued the Certificates whose revocation
+	   status is being checked, or
+	2. Be signed by an OCSP Responder whose Certificate is signed by the
+	   CA that issued the Certificate whose revocation status is being
+	   checked.
+In the latter case, the OCSP signing Certificate MUST contain an
+extension of type id-pkix-ocsp-nocheck, as defined by RFC 6960.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspResponderMissingOCSPNoCheck struct{}
+
+func (l *ocspResponderMissingOCSPNoCheck) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderMissingOCSPNoCheck) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return util.HasOCSPResponderCert(resp)
+}
+
+func (l *ocspResponderMissingOCSPNoCheck) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	cert, _ := util.GetOCSPResponderCert(resp)
+	if util.IsExtInCert(cert, util.OscpNoCheckOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_responder_missing_ocsp_no_check",
+		Description:   "A delegated OCSP responder certificate MUST contain the id-pkix-ocsp-nocheck extension",
+		Citation:      "BRs: 4.9.9",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ocspResponderMissingOCSPNoCheck{},
+	})
+}
@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.2.2 Authorized Responders
+[...] a certificate's issuer MUST do one of the following:
+	- sign the OCSP responses itself, or
+	- explicitly designate this authority to another entity
+
+The CA designates the OCSP signing authority by including a value of
+id-kp-OCSPSigning in an extended key usage extension included in the
+OCSP response signer's certificate. This certificate MUST be issued
+directly by the CA that is identified in the request.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspResponderMissingOCSPSigningEKU struct{}
+
+func (l *ocspResponderMissingOCSPSigningEKU) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderMissingOCSPSigningEKU) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return util.HasOCSPResponderCert(resp)
+}
+
+func (l *ocspResponderMissingOCSPSigningEKU) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	cert, _ := util.GetOCSPResponderCert(resp)
+	for _, eku := range cert.ExtKeyUsage {
+		if eku == x509.ExtKeyUsageOcspSigning {
+			return &LintResult{Status: Pass}
+		}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_responder_missing_ocsp_signing_eku",
+		Description:   "A delegated OCSP responder certificate MUST include id-kp-OCSPSigning in its extended key usage",
+		Citation:      "RFC 6960: 4.2.2.2",
+		Source:        RFC6960,
+		EffectiveDate: util.RFC6960Date,
+		Lint:          &ocspResponderMissingOCSPSigningEKU{},
+	})
+}
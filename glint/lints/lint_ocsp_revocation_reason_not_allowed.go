@@ -0,0 +1,78 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.1
+RevokedInfo ::= SEQUENCE {
+    revocationTime              GeneralizedTime,
+    revocationReason    [0]     EXPLICIT CRLReason OPTIONAL }
+
+CRLReason is defined in RFC 5280: 5.3.1; the value 7 is not used.
+
+BRfCSC: 4.9.13 Circumstances for suspension
+The Repository MUST NOT include entries that indicate that a
+Certificate is suspended, so certificateHold (6) is never allowed.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspRevocationReasonNotAllowed struct{}
+
+func (l *ocspRevocationReasonNotAllowed) Initialize() error {
+	return nil
+}
+
+func (l *ocspRevocationReasonNotAllowed) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	for i := range resp.TBSResponseData.Responses {
+		if util.IsOCSPRevoked(&resp.TBSResponseData.Responses[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ocspRevocationReasonNotAllowed) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for i := range resp.TBSResponseData.Responses {
+		single := &resp.TBSResponseData.Responses[i]
+		if !util.IsOCSPRevoked(single) {
+			continue
+		}
+		code := int(single.Revoked.RevocationReason)
+		switch {
+		case code == util.ReasonCertificateHold:
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: certificateHold", single.CertID.SerialNumber)}
+		case code < util.ReasonUnspecified || code == 7 || code > util.ReasonAACompromise:
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s: unknown reason %d", single.CertID.SerialNumber, code)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_revocation_reason_not_allowed",
+		Description:   "The revocationReason of an OCSP response MUST be a defined CRLReason other than certificateHold",
+		Citation:      "BRs: 4.9.13; RFC 5280: 5.3.1",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ocspRevocationReasonNotAllowed{},
+	})
+}
@@ -0,0 +1,72 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.1 & 4.2.2.1
+RevokedInfo ::= SEQUENCE {
+    revocationTime              GeneralizedTime,
+    revocationReason    [0]     EXPLICIT CRLReason OPTIONAL }
+
+thisUpdate is the most recent time at which the status being
+indicated is known to have been correct. A revoked status cannot have
+been known to be correct before the revocation happened, so
+revocationTime is never later than thisUpdate. Code signing
+revocations are commonly backdated; a future-dated one leaves
+signatures made before that date trusted.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspRevocationTimeAfterThisUpdate struct{}
+
+func (l *ocspRevocationTimeAfterThisUpdate) Initialize() error {
+	return nil
+}
+
+func (l *ocspRevocationTimeAfterThisUpdate) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	for i := range resp.TBSResponseData.Responses {
+		if util.IsOCSPRevoked(&resp.TBSResponseData.Responses[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ocspRevocationTimeAfterThisUpdate) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for i := range resp.TBSResponseData.Responses {
+		single := &resp.TBSResponseData.Responses[i]
+		if util.IsOCSPRevoked(single) && single.Revoked.RevocationTime.After(single.ThisUpdate) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", single.CertID.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_revocation_time_after_this_update",
+		Description:   "The revocationTime of a revoked OCSP response MUST NOT be later than its thisUpdate",
+		Citation:      "RFC 6960: 4.2.2.1",
+		Source:        RFC6960,
+		EffectiveDate: util.RFC6960Date,
+		Lint:          &ocspRevocationTimeAfterThisUpdate{},
+	})
+}
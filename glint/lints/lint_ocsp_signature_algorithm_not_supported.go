@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 7.1.3.2.1 RSA
+The CA SHALL use one of the following signature algorithms:
+	• RSASSA-PKCS1-v1_5 with SHA-256, SHA-384 or SHA-512
+	• RSASSA-PSS with SHA-256, SHA-384 or SHA-512
+In addition, the CA MAY use RSASSA-PKCS1-v1_5 with SHA-1 if [...]
+	• It is used within an OCSP response; or,
+	• It is used within a CRL; [...]
+
+BRfCSC: 7.1.3.2.3 DSA
+The CA SHALL use the following signature algorithm:
+	• DSA with SHA-256
+In addition, the CA MAY use DSA with SHA-1 if [...]
+	• It is used within an OCSP response; [...]
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspSignatureAlgorithmNotSupported struct{}
+
+func (l *ocspSignatureAlgorithmNotSupported) Initialize() error {
+	return nil
+}
+
+func (l *ocspSignatureAlgorithmNotSupported) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return true
+}
+
+func (l *ocspSignatureAlgorithmNotSupported) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	sigAlg := x509.GetSignatureAlgorithmFromAI(resp.SignatureAlgorithm)
+	// The algorithm policy timeline permits every algorithm allowed for
+	// certificates in OCSP responses, and SHA-1 with RSA or DSA is carved out
+	// explicitly.
+	if _, ok := util.GetSignaturePolicy(sigAlg, util.RoleOCSP, resp.TBSResponseData.ProducedAt); ok {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error, Details: sigAlg.String()}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_signature_algorithm_not_supported",
+		Description:   "OCSP responses MUST be signed with an algorithm allowed by the BRs, which permit SHA-1 with RSA or DSA for OCSP",
+		Citation:      "BRs: 7.1.3.2.1 and 7.1.3.2.3",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ocspSignatureAlgorithmNotSupported{},
+	})
+}
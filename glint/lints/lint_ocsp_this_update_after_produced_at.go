@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 6960: 4.2.2.1 Time
+producedAt: The time at which the OCSP responder signed this response.
+
+thisUpdate: The most recent time at which the status being indicated
+is known by the responder to have been correct.
+
+A responder cannot know the status to be correct at a time after it
+signed the response, so thisUpdate is never later than producedAt.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspThisUpdateAfterProducedAt struct{}
+
+func (l *ocspThisUpdateAfterProducedAt) Initialize() error {
+	return nil
+}
+
+func (l *ocspThisUpdateAfterProducedAt) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	return len(resp.TBSResponseData.Responses) > 0
+}
+
+func (l *ocspThisUpdateAfterProducedAt) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	producedAt := resp.TBSResponseData.ProducedAt
+	for _, single := range resp.TBSResponseData.Responses {
+		if single.ThisUpdate.After(producedAt) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", single.CertID.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_this_update_after_produced_at",
+		Description:   "The thisUpdate of an OCSP response MUST NOT be later than its producedAt",
+		Citation:      "RFC 6960: 4.2.2.1",
+		Source:        RFC6960,
+		EffectiveDate: util.RFC6960Date,
+		Lint:          &ocspThisUpdateAfterProducedAt{},
+	})
+}
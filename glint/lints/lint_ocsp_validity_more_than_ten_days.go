@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRfCSC: 4.9.10 On-line revocation checking requirements
+[...] the CA SHALL update information provided via an Online
+Certificate Status Protocol at least every seven days. OCSP responses
+from this service MUST have a maximum expiration time of ten days.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+type ocspValidityMoreThanTenDays struct{}
+
+func (l *ocspValidityMoreThanTenDays) Initialize() error {
+	return nil
+}
+
+func (l *ocspValidityMoreThanTenDays) CheckApplies(resp *ocsp.BasicOCSPResponse) bool {
+	for _, single := range resp.TBSResponseData.Responses {
+		if !single.NextUpdate.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ocspValidityMoreThanTenDays) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	for _, single := range resp.TBSResponseData.Responses {
+		if single.NextUpdate.IsZero() {
+			continue
+		}
+		if single.NextUpdate.After(single.ThisUpdate.AddDate(0, 0, 10)) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("serial %s", single.CertID.SerialNumber)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterOCSPLint(&OCSPLint{
+		Name:          "e_ocsp_validity_more_than_ten_days",
+		Description:   "OCSP responses MUST have a maximum expiration time of ten days",
+		Citation:      "BRs: 4.9.10",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &ocspValidityMoreThanTenDays{},
+	})
+}
@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4
+6.1.5.1 Root CA and Subordinate CA Key Sizes
+	For Keys corresponding to Root and Subordinate CA Certificates:
+		• If the Key is RSA, then the modulus MUST be at least 4096 bits in length.
+		• If the Key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.
+
+6.1.5.2 Code Signing Certificate and Timestamp Authority Key Sizes
+	For Keys corresponding to Subscriber code signing and Timestamp Authority
+	Certificates:
+		• If the Key is RSA, then the modulus MUST be at least 3072 bits in length.
+		• If the Key is ECDSA, then the curve MUST be one of NIST P‐256, P‐384, or
+		  P‐521.
+		• If the Key is DSA, then one of the following key parameter options MUST
+		  be used:
+			• Key length (L) of 2048 bits and modulus length (N) of 224 bits
+			• Key length (L) of 2048 bits and modulus length (N) of 256 bits
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type publicKeyNotPermitted struct{}
+
+func (l *publicKeyNotPermitted) Initialize() error {
+	return nil
+}
+
+func (l *publicKeyNotPermitted) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithm != x509.UnknownPublicKeyAlgorithm
+}
+
+func (l *publicKeyNotPermitted) Execute(c *x509.Certificate) *LintResult {
+	if err := util.CheckKeyPolicy(c); err != nil {
+		return &LintResult{Status: Error, Details: err.Error()}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_public_key_not_permitted",
+		Description:   "The subject public key MUST be of an algorithm and size the BRs permit for the kind of certificate at its notBefore: RSA of 2048 bits (3072 for Subscribers and 4096 for CAs from June 2021), DSA L of 2048 bits, or ECDSA on P-256, P-384 or P-521.",
+		Citation:      "BRs: 6.1.5.1, 6.1.5.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.BRfCSCV21MinCryptoEffectiveDate,
+		Lint:          &publicKeyNotPermitted{},
+	})
+}
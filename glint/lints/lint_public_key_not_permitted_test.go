@@ -0,0 +1,107 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestPublicKeyNotPermittedRSA2048Subscriber2022(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgRSA2048Subscriber2022.pem"
+	expected := Error
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedRSA2048Subscriber2020(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgRSA2048Subscriber2020.pem"
+	expected := Pass
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedRSA3072SubCA(t *testing.T) {
+	inputPath := "../testlint/testCerts/csSubCARSA3072.pem"
+	expected := Error
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedRSA2048SubCA2020(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSubCARSA2048In2020.pem"
+	expected := Pass
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedRSA4096SubCA(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSubCA2022.pem"
+	expected := Pass
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedRSA2048TSA(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSARsa2048.pem"
+	expected := Error
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedECP224Root(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRootCAECP224.pem"
+	expected := Error
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedECP384Root(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRootCAECP384.pem"
+	expected := Pass
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedDSA1024(t *testing.T) {
+	inputPath := "../testlint/testCerts/dsaShorterThan2048Bits.pem"
+	expected := Error
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPublicKeyNotPermittedDSA2048(t *testing.T) {
+	inputPath := "../testlint/testCerts/dsaNotShorterThan2048Bits.pem"
+	expected := Pass
+	out := Lints["e_public_key_not_permitted"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
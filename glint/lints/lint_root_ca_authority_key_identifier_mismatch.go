@@ -0,0 +1,63 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.1
+The keyIdentifier field of the authorityKeyIdentifier extension MUST
+be included in all certificates generated by conforming CAs to
+facilitate certification path construction. There is one exception;
+where a CA distributes its public key in the form of a "self-signed"
+certificate, the authority key identifier MAY be omitted. The
+signature on a self-signed certificate is generated with the private
+key associated with the certificate's subject public key.
+************************************************/
+
+import (
+	"bytes"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rootCAAkiMismatch struct{}
+
+func (l *rootCAAkiMismatch) Initialize() error {
+	return nil
+}
+
+func (l *rootCAAkiMismatch) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c) && len(c.AuthorityKeyId) > 0
+}
+
+func (l *rootCAAkiMismatch) Execute(c *x509.Certificate) *LintResult {
+	// A self-signed certificate is its own issuer, so an authority key
+	// identifier, when present, must identify its own key.
+	if bytes.Equal(c.AuthorityKeyId, c.SubjectKeyId) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_root_ca_authority_key_identifier_mismatch",
+		Description:   "Root CA Certificate: authorityKeyIdentifier MAY be omitted, but when present its keyIdentifier MUST match the subjectKeyIdentifier.",
+		Citation:      "RFC 5280: 4.2.1.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &rootCAAkiMismatch{},
+	})
+}
@@ -0,0 +1,55 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.1.a basicConstraints
+	This extension MUST appear as a critical extension. The cA field MUST be set
+	true. The pathLenConstraint field SHOULD NOT be present.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rootCAPathLenPresent struct{}
+
+func (l *rootCAPathLenPresent) Initialize() error {
+	return nil
+}
+
+func (l *rootCAPathLenPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c) && util.IsExtInCert(c, util.BasicConstOID)
+}
+
+func (l *rootCAPathLenPresent) Execute(c *x509.Certificate) *LintResult {
+	if c.MaxPathLen > 0 || c.MaxPathLenZero {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_root_ca_basic_constraints_path_len_constraint_field_present",
+		Description:   "Root CA Certificate: basicConstraints The pathLenConstraint field SHOULD NOT be present.",
+		Citation:      "BRs: 7.1.2.1.a",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rootCAPathLenPresent{},
+	})
+}
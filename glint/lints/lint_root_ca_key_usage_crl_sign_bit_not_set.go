@@ -0,0 +1,56 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.1.b keyUsage
+	This extension MUST be present and MUST be marked critical. Bit positions
+	for keyCertSign and cRLSign MUST be set. If the Root CA Private Key is used
+	for signing OCSP responses, then the digitalSignature bit MUST be set.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rootCAKeyUsageCRLSignNotSet struct{}
+
+func (l *rootCAKeyUsageCRLSignNotSet) Initialize() error {
+	return nil
+}
+
+func (l *rootCAKeyUsageCRLSignNotSet) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c) && util.IsExtInCert(c, util.KeyUsageOID)
+}
+
+func (l *rootCAKeyUsageCRLSignNotSet) Execute(c *x509.Certificate) *LintResult {
+	if c.KeyUsage&x509.KeyUsageCRLSign != 0 {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_root_ca_key_usage_crl_sign_bit_not_set",
+		Description:   "Root CA Certificate: keyUsage The bit position for cRLSign MUST be set.",
+		Citation:      "BRs: 7.1.2.1.b",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rootCAKeyUsageCRLSignNotSet{},
+	})
+}
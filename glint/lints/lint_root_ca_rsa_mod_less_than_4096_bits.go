@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.5.1 Root CA and Subordinate CA Key Sizes
+	For Keys corresponding to Root and Subordinate CA Certificates:
+		• If the Key is RSA, then the modulus MUST be at least 4096 bits in length.
+		• If the Key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rootCARsaModSize struct{}
+
+func (l *rootCARsaModSize) Initialize() error {
+	return nil
+}
+
+func (l *rootCARsaModSize) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA && util.IsRootCA(c)
+}
+
+func (l *rootCARsaModSize) Execute(c *x509.Certificate) *LintResult {
+	key := c.PublicKey.(*rsa.PublicKey)
+	if key.N.BitLen() < util.GetMinKeyBits(x509.RSA, util.GetAlgorithmRole(c), c.NotBefore) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_root_ca_rsa_mod_less_than_4096_bits",
+		Description:   "Root CA Certificate: If the key is RSA, then the modulus MUST be at least 4096 bits in length.",
+		Citation:      "BRs: 6.1.5.1",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.BRfCSCV21KeySizeTransitionDate,
+		Lint:          &rootCARsaModSize{},
+	})
+}
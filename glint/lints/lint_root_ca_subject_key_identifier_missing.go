@@ -0,0 +1,56 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.2
+To facilitate certification path construction, this extension MUST
+appear in all conforming CA certificates, that is, all certificates
+including the basic constraints extension (Section 4.2.1.9) where the
+value of cA is TRUE.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rootCASkiMissing struct{}
+
+func (l *rootCASkiMissing) Initialize() error {
+	return nil
+}
+
+func (l *rootCASkiMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCASkiMissing) Execute(c *x509.Certificate) *LintResult {
+	if util.IsExtInCert(c, util.SubjectKeyIdentityOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_root_ca_subject_key_identifier_missing",
+		Description:   "Root CA Certificate: subjectKeyIdentifier MUST appear in all conforming CA certificates.",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &rootCASkiMissing{},
+	})
+}
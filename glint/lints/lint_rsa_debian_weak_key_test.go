@@ -0,0 +1,61 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/weakkeys"
+)
+
+// withBlocklist makes csRsaDebianWeak.blacklist the default weak key
+// blocklist for the duration of a test.
+func withBlocklist(t *testing.T) func() {
+	blocklist := weakkeys.NewBlocklist()
+	if err := blocklist.AppendFromPath("../testlint/testCerts/csRsaDebianWeak.blacklist"); err != nil {
+		t.Fatal(err)
+	}
+	weakkeys.SetDefault(blocklist)
+	return func() { weakkeys.SetDefault(weakkeys.NewBlocklist()) }
+}
+
+func TestRsaDebianWeakKey(t *testing.T) {
+	defer withBlocklist(t)()
+	inputPath := "../testlint/testCerts/csRsaDebianWeak.pem"
+	expected := Error
+	out := Lints["e_rsa_debian_weak_key"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestRsaNotDebianWeakKey(t *testing.T) {
+	defer withBlocklist(t)()
+	inputPath := "../testlint/testCerts/csRsaKeyValid.pem"
+	expected := Pass
+	out := Lints["e_rsa_debian_weak_key"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestRsaDebianWeakKeyNoBlocklist(t *testing.T) {
+	inputPath := "../testlint/testCerts/csRsaDebianWeak.pem"
+	expected := NA
+	out := Lints["e_rsa_debian_weak_key"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
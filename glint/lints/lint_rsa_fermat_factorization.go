@@ -0,0 +1,78 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.1.3 Subscriber Key Pair Generation
+	The CA SHALL reject a certificate request if one or more of the following
+	conditions are met:
+		1. The Key Pair does not meet the requirements set forth in Section 6.1.5
+		   and/or Section 6.1.6;
+		2. There is clear evidence that the specific method used to generate the
+		   Private Key was flawed;
+		3. The CA is aware of a demonstrated or proven method that exposes the
+		   Applicant's Private Key to compromise;
+		...
+		5. The CA is aware of a demonstrated or proven method to easily compute
+		   the Applicant's Private Key based on the Public Key (such as a Debian
+		   weak key, see https://wiki.debian.org/SSLkeys).
+
+Fermat's factorization method recovers the prime factors of a modulus in a
+handful of rounds when the two primes are close to each other, as happens
+with some flawed key generators.
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// fermatRounds bounds the work done per certificate. Keys with primes
+// that close are factored within a few rounds.
+const fermatRounds = 100
+
+type rsaFermatFactorization struct{}
+
+func (l *rsaFermatFactorization) Initialize() error {
+	return nil
+}
+
+func (l *rsaFermatFactorization) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *rsaFermatFactorization) Execute(c *x509.Certificate) *LintResult {
+	key := c.PublicKey.(*rsa.PublicKey)
+	if p, _ := util.FermatFactor(key.N, fermatRounds); p != nil {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("modulus factored with prime %s", p.Text(16))}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_rsa_fermat_factorization",
+		Description:   "RSA: The modulus MUST NOT be factorable with Fermat's method, i.e. its prime factors MUST NOT be close to each other.",
+		Citation:      "BRs: 6.1.1.3",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rsaFermatFactorization{},
+	})
+}
@@ -0,0 +1,73 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.1.3 Subscriber Key Pair Generation
+	The CA SHALL reject a certificate request if one or more of the following
+	conditions are met:
+		1. The Key Pair does not meet the requirements set forth in Section 6.1.5
+		   and/or Section 6.1.6;
+		2. There is clear evidence that the specific method used to generate the
+		   Private Key was flawed;
+		3. The CA is aware of a demonstrated or proven method that exposes the
+		   Applicant's Private Key to compromise;
+		...
+		5. The CA is aware of a demonstrated or proven method to easily compute
+		   the Applicant's Private Key based on the Public Key (such as a Debian
+		   weak key, see https://wiki.debian.org/SSLkeys).
+
+ROCA (CVE-2017-15361): RSA keys generated by the Infineon RSALib have prime
+factors of a special form that allows the private key to be computed from
+the public key.
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rsaKeyROCAVulnerable struct{}
+
+func (l *rsaKeyROCAVulnerable) Initialize() error {
+	return nil
+}
+
+func (l *rsaKeyROCAVulnerable) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *rsaKeyROCAVulnerable) Execute(c *x509.Certificate) *LintResult {
+	key := c.PublicKey.(*rsa.PublicKey)
+	if util.IsROCAVulnerable(key.N) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_rsa_key_roca_vulnerable",
+		Description:   "RSA: The key MUST NOT be one generated by the Infineon RSALib (ROCA, CVE-2017-15361), whose private key can be computed from the public key.",
+		Citation:      "BRs: 6.1.1.3",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rsaKeyROCAVulnerable{},
+	})
+}
@@ -0,0 +1,90 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.1.3 Subscriber Key Pair Generation
+	The CA SHALL reject a certificate request if one or more of the following
+	conditions are met:
+		...
+		5. The CA is aware of a demonstrated or proven method to easily compute
+		   the Applicant's Private Key based on the Public Key (such as a Debian
+		   weak key, see https://wiki.debian.org/SSLkeys).
+
+An RSA modulus sharing a prime with another modulus of the corpus is factored
+by their greatest common divisor.
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/corpus"
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rsaModSharesFactorInCorpus struct {
+	ids    []string
+	moduli []*big.Int
+}
+
+func (l *rsaModSharesFactorInCorpus) Initialize() error {
+	l.ids = nil
+	l.moduli = nil
+	return nil
+}
+
+func (l *rsaModSharesFactorInCorpus) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok
+}
+
+// Observe only collects the modulus: whether it shares a factor is known
+// once the whole corpus has been seen.
+func (l *rsaModSharesFactorInCorpus) Observe(e *CorpusEntry) []*CorpusResult {
+	l.ids = append(l.ids, e.ID)
+	l.moduli = append(l.moduli, e.Cert.PublicKey.(*rsa.PublicKey).N)
+	return nil
+}
+
+func (l *rsaModSharesFactorInCorpus) Finish() []*CorpusResult {
+	results := make([]*CorpusResult, len(l.ids))
+	for i, id := range l.ids {
+		results[i] = &CorpusResult{ID: id, LintResult: LintResult{Status: Pass}}
+	}
+	for _, f := range corpus.SharedFactors(l.moduli) {
+		peers := make([]string, len(f.Peers))
+		for i, p := range f.Peers {
+			peers[i] = l.ids[p]
+		}
+		results[f.Index].LintResult = LintResult{Status: Error, Details: fmt.Sprintf("shares a %d-bit factor with %s", f.Factor.BitLen(), strings.Join(peers, ", "))}
+	}
+	return results
+}
+
+func init() {
+	RegisterCorpusLint(&CorpusLint{
+		Name:          "e_rsa_mod_shares_factor_in_corpus",
+		Description:   "RSA: The modulus MUST NOT share a prime factor with the modulus of another certificate, which makes its Private Key easy to compute.",
+		Citation:      "BRs: 6.1.1.3",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rsaModSharesFactorInCorpus{},
+	})
+}
@@ -0,0 +1,30 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestRsaModSharesFactorInCorpus(t *testing.T) {
+	gcd1 := "../testlint/testCerts/csCorpusGCD1.pem"
+	gcd2 := "../testlint/testCerts/csCorpusGCD2.pem"
+	gcd3 := "../testlint/testCerts/csCorpusGCD3.pem"
+	ecdsa := "../testlint/testCerts/csCorpusSerial3.pem"
+	out := ExecuteCorpusLint("e_rsa_mod_shares_factor_in_corpus", gcd1, gcd2, gcd3, ecdsa)
+	for inputPath, expected := range map[string]LintStatus{gcd1: Error, gcd2: Error, gcd3: Pass, ecdsa: NA} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
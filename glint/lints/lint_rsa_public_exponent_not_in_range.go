@@ -0,0 +1,63 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.6 Public key parameters generation and quality checking
+	RSA: The CA SHALL confirm that the value of the public exponent is an odd
+	number equal to 3 or more. Additionally, the public exponent SHOULD be in the
+	range between 2^16+1 and 2^256-1. The modulus SHOULD also have the following
+	characteristics: an odd number, not the power of a prime, and have no factors
+	smaller than 752. [Citation: Section 5.3.3, NIST SP 800-89].
+******************************************************************************/
+
+import (
+	"crypto/rsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type rsaPublicExponentNotInRange struct{}
+
+func (l *rsaPublicExponentNotInRange) Initialize() error {
+	return nil
+}
+
+func (l *rsaPublicExponentNotInRange) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *rsaPublicExponentNotInRange) Execute(c *x509.Certificate) *LintResult {
+	key := c.PublicKey.(*rsa.PublicKey)
+	// The exponent is parsed into an int, so it is always below 2^256 - 1.
+	if key.E >= 65537 {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Warn}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_rsa_public_exponent_not_in_range",
+		Description:   "RSA: The public exponent SHOULD be in the range between 2^16 + 1 and 2^256 - 1.",
+		Citation:      "BRs: 6.1.6",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &rsaPublicExponentNotInRange{},
+	})
+}
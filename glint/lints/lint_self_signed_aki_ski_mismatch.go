@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.1
+	The identification MAY be based on either the
+	key identifier (the subject key identifier in the issuer's
+	certificate) or the issuer name and serial number.
+
+	The keyIdentifier field of the authorityKeyIdentifier extension MUST
+	be included in all certificates generated by conforming CAs to
+	facilitate certification path construction.  There is one exception;
+	where a CA distributes its public key in the form of a "self-signed"
+	certificate, the authority key identifier MAY be omitted.
+
+Self-signed CA certificates are checked by
+e_root_ca_authority_key_identifier_mismatch; this lint covers self-signed end
+entity certificates, whose issuer's certificate is the certificate itself.
+******************************************************************************/
+
+import (
+	"bytes"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type selfSignedAkiSkiMismatch struct{}
+
+func (l *selfSignedAkiSkiMismatch) Initialize() error {
+	return nil
+}
+
+func (l *selfSignedAkiSkiMismatch) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSelfSigned(c) && !util.IsCACert(c) && len(c.AuthorityKeyId) > 0 && len(c.SubjectKeyId) > 0
+}
+
+func (l *selfSignedAkiSkiMismatch) Execute(c *x509.Certificate) *LintResult {
+	if !bytes.Equal(c.AuthorityKeyId, c.SubjectKeyId) {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_self_signed_aki_ski_mismatch",
+		Description:   "Self-signed end entity Certificate: the authorityKeyIdentifier keyIdentifier, when present, should equal the subjectKeyIdentifier, since both identify the same key.",
+		Citation:      "RFC 5280: 4.2.1.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &selfSignedAkiSkiMismatch{},
+	})
+}
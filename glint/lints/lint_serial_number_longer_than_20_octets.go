@@ -0,0 +1,72 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.2
+	The serial number MUST be a positive integer assigned by the CA to each
+	certificate.  It MUST be unique for each certificate issued by a given
+	CA (i.e., the issuer name and serial number identify a unique
+	certificate).  CAs MUST force the serialNumber to be a non-negative
+	integer.
+
+	Given the uniqueness requirements above, serial numbers can be
+	expected to contain long integers.  Certificate users MUST be able to
+	handle serialNumber values up to 20 octets.  Conforming CAs MUST NOT
+	use serialNumber values longer than 20 octets.
+
+	Note: Non-conforming CAs may issue certificates with serial numbers
+	that are negative or zero.  Certificate users SHOULD be prepared to
+	gracefully handle such certificates.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type serialNumberTooLong struct{}
+
+func (l *serialNumberTooLong) Initialize() error {
+	return nil
+}
+
+func (l *serialNumberTooLong) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *serialNumberTooLong) Execute(c *x509.Certificate) *LintResult {
+	tbs, err := util.ParseTBSCertificate(c.RawTBSCertificate)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if n := len(tbs.SerialNumber.Content); n > 20 {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("serial number is %d octets", n)}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_serial_number_longer_than_20_octets",
+		Description:   "Certificates MUST NOT have a serial number longer than 20 octets.",
+		Citation:      "RFC 5280: 4.1.2.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &serialNumberTooLong{},
+	})
+}
@@ -0,0 +1,83 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.2
+The serial number MUST be a positive integer assigned by the CA to each
+certificate. It MUST be unique for each certificate issued by a given CA
+(i.e., the issuer name and serial number identify a unique certificate).
+************************************************/
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type issuedSerial struct {
+	fingerprint [sha256.Size]byte
+	id          string
+}
+
+type serialNumberNotUniquePerIssuer struct {
+	// serials maps each issuer and serial number to the first certificate
+	// seen with them.
+	serials map[string]issuedSerial
+}
+
+func (l *serialNumberNotUniquePerIssuer) Initialize() error {
+	l.serials = make(map[string]issuedSerial)
+	return nil
+}
+
+func (l *serialNumberNotUniquePerIssuer) CheckApplies(c *x509.Certificate) bool {
+	return c.SerialNumber != nil
+}
+
+func (l *serialNumberNotUniquePerIssuer) Observe(e *CorpusEntry) []*CorpusResult {
+	key := corpusIssuer(e.Cert) + "\x00" + e.Cert.SerialNumber.String()
+	fingerprint := sha256.Sum256(e.Cert.Raw)
+	first, ok := l.serials[key]
+	if !ok {
+		l.serials[key] = issuedSerial{fingerprint: fingerprint, id: e.ID}
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	}
+	// The same certificate may appear more than once in a corpus.
+	if first.fingerprint == fingerprint {
+		return []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	}
+	return []*CorpusResult{
+		{ID: e.ID, LintResult: LintResult{Status: Error, Details: fmt.Sprintf("serial number already used by %s", first.id)}},
+		{ID: first.id, LintResult: LintResult{Status: Error, Details: fmt.Sprintf("serial number reused by %s", e.ID)}},
+	}
+}
+
+func (l *serialNumberNotUniquePerIssuer) Finish() []*CorpusResult {
+	return nil
+}
+
+func init() {
+	RegisterCorpusLint(&CorpusLint{
+		Name:          "e_serial_number_not_unique_per_issuer",
+		Description:   "The serial number MUST be unique for each certificate issued by a given CA.",
+		Citation:      "RFC 5280: 4.1.2.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &serialNumberNotUniquePerIssuer{},
+	})
+}
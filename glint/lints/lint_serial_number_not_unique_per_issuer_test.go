@@ -0,0 +1,45 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestSerialNumberNotUniquePerIssuer(t *testing.T) {
+	serial1 := "../testlint/testCerts/csCorpusSerial1.pem"
+	dup := "../testlint/testCerts/csCorpusSerialDup.pem"
+	serial3 := "../testlint/testCerts/csCorpusSerial3.pem"
+	out := ExecuteCorpusLint("e_serial_number_not_unique_per_issuer", serial1, serial3, dup)
+	for inputPath, expected := range map[string]LintStatus{serial1: Error, dup: Error, serial3: Pass} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestSerialNumberUniqueSameCertificateTwice(t *testing.T) {
+	inputPath := "../testlint/testCerts/csCorpusSerial1.pem"
+	expected := Pass
+	entries := ReadCorpus(inputPath, inputPath)
+	entries[1].ID = inputPath + "#2"
+	out, err := CorpusLints["e_serial_number_not_unique_per_issuer"].ExecuteCorpus(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, res := range out {
+		if res.Status != expected {
+			t.Errorf("%s: expected %s, got %s", id, expected, res.Status)
+		}
+	}
+}
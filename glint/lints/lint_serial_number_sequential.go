@@ -0,0 +1,89 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1
+Effective September 30, 2016, CAs SHALL generate non-sequential Certificate
+serial numbers greater than zero (0) containing at least 64 bits of output
+from a CSPRNG.
+************************************************/
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+var bigOne = big.NewInt(1)
+
+type serialNumberSequential struct {
+	// serials maps each issuer to the serial numbers seen from it, and each
+	// serial number to the ID of the first certificate that carried it.
+	serials map[string]map[string]string
+}
+
+func (l *serialNumberSequential) Initialize() error {
+	l.serials = make(map[string]map[string]string)
+	return nil
+}
+
+func (l *serialNumberSequential) CheckApplies(c *x509.Certificate) bool {
+	return c.SerialNumber != nil
+}
+
+func (l *serialNumberSequential) Observe(e *CorpusEntry) []*CorpusResult {
+	issuer := corpusIssuer(e.Cert)
+	seen, ok := l.serials[issuer]
+	if !ok {
+		seen = make(map[string]string)
+		l.serials[issuer] = seen
+	}
+	serial := e.Cert.SerialNumber
+	results := []*CorpusResult{{ID: e.ID, LintResult: LintResult{Status: Pass}}}
+	for i, neighbour := range []*big.Int{new(big.Int).Sub(serial, bigOne), new(big.Int).Add(serial, bigOne)} {
+		id, ok := seen[neighbour.String()]
+		if !ok {
+			continue
+		}
+		order, reverse := "follows", "precedes"
+		if i == 1 {
+			order, reverse = reverse, order
+		}
+		results[0].LintResult = LintResult{Status: Error, Details: fmt.Sprintf("serial number %s %s", order, id)}
+		results = append(results, &CorpusResult{ID: id, LintResult: LintResult{Status: Error, Details: fmt.Sprintf("serial number %s %s", reverse, e.ID)}})
+	}
+	if _, ok := seen[serial.String()]; !ok {
+		seen[serial.String()] = e.ID
+	}
+	return results
+}
+
+func (l *serialNumberSequential) Finish() []*CorpusResult {
+	return nil
+}
+
+func init() {
+	RegisterCorpusLint(&CorpusLint{
+		Name:          "e_serial_number_sequential",
+		Description:   "Effective September 30, 2016, CAs SHALL generate non-sequential Certificate serial numbers; no two certificates from the same issuer may have consecutive serial numbers.",
+		Citation:      "BRs: 7.1",
+		Source:        CABFBaselineRequirements,
+		EffectiveDate: util.BRfCSCNonSequentialDate,
+		Lint:          &serialNumberSequential{},
+	})
+}
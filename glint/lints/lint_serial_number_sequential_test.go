@@ -0,0 +1,71 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/truststore"
+)
+
+func TestSerialNumberSequential(t *testing.T) {
+	serial1 := "../testlint/testCerts/csCorpusSerial1.pem"
+	serial2 := "../testlint/testCerts/csCorpusSerial2.pem"
+	serial3 := "../testlint/testCerts/csCorpusSerial3.pem"
+	out := ExecuteCorpusLint("e_serial_number_sequential", serial1, serial3, serial2)
+	for inputPath, expected := range map[string]LintStatus{serial1: Error, serial2: Error, serial3: Pass} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestSerialNumberSequentialDetails(t *testing.T) {
+	serial1 := "../testlint/testCerts/csCorpusSerial1.pem"
+	serial2 := "../testlint/testCerts/csCorpusSerial2.pem"
+	out := ExecuteCorpusLint("e_serial_number_sequential", serial2, serial1)
+	for inputPath, expected := range map[string]string{serial1: "serial number precedes " + serial2, serial2: "serial number follows " + serial1} {
+		if out[inputPath].Details != expected {
+			t.Errorf("%s: expected details %q, got %q", inputPath, expected, out[inputPath].Details)
+		}
+	}
+}
+
+func TestSerialNumberSequentialOutOfScope(t *testing.T) {
+	truststore.SetDefault([]*truststore.Store{truststore.NewStore("empty", chain.NewPool())}, true)
+	defer truststore.SetDefault(nil, false)
+	serial1 := "../testlint/testCerts/csCorpusSerial1.pem"
+	serial2 := "../testlint/testCerts/csCorpusSerial2.pem"
+	expected := NA
+	out := ExecuteCorpusLint("e_serial_number_sequential", serial1, serial2)
+	for _, inputPath := range []string{serial1, serial2} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
+
+func TestSerialNumberSequentialBeforeEffective(t *testing.T) {
+	old1 := "../testlint/testCerts/csCorpusSerialOld1.pem"
+	old2 := "../testlint/testCerts/csCorpusSerialOld2.pem"
+	expected := NE
+	out := ExecuteCorpusLint("e_serial_number_sequential", old1, old2)
+	for _, inputPath := range []string{old1, old2} {
+		if out[inputPath].Status != expected {
+			t.Errorf("%s: expected %s, got %s", inputPath, expected, out[inputPath].Status)
+		}
+	}
+}
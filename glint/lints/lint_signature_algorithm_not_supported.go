@@ -59,33 +59,6 @@ First seen: BRfCSC v3.0
 
 *****************************************************************************
 */
-var (
-	// Any of the following x509.SignatureAlgorithms are acceptable per §7.1.3.2 of
-	// the BRs.
-	passSigAlgs = map[x509.SignatureAlgorithm]bool{
-		x509.SHA256WithRSA:   true,
-		x509.SHA384WithRSA:   true,
-		x509.SHA512WithRSA:   true,
-		x509.DSAWithSHA256:   true,
-		x509.ECDSAWithSHA256: true,
-		x509.ECDSAWithSHA384: true,
-		x509.ECDSAWithSHA512: true,
-	}
-	// The BRs do not forbid the use of RSA-PSS as a signature scheme in
-	// certificates but it is not broadly supported by user-agents. Since
-	// the BRs do not forbid the practice we return a warning result.
-	// NOTE: The Mozilla root program policy *does* forbid their use since v2.7.
-	// This should be covered by a lint scoped to the Mozilla source instead of in
-	// this CABF lint.
-	warnSigAlgs = map[x509.SignatureAlgorithm]bool{
-		x509.SHA256WithRSAPSS: true,
-		x509.SHA384WithRSAPSS: true,
-		x509.SHA512WithRSAPSS: true,
-		x509.DSAWithSHA1:      true,
-		x509.SHA1WithRSA:      true,
-	}
-)
-
 func (l *signatureAlgorithmNotSupported) Initialize() error {
 	return nil
 }
@@ -95,16 +68,20 @@ func (l *signatureAlgorithmNotSupported) CheckApplies(c *x509.Certificate) bool
 }
 
 func (l *signatureAlgorithmNotSupported) Execute(c *x509.Certificate) *LintResult {
-	sigAlg := c.SignatureAlgorithm
-	status := Error
-	if passSigAlgs[sigAlg] {
-		status = Pass
-	} else if warnSigAlgs[sigAlg] {
-		status = Warn
+	p, err := util.CheckSignaturePolicy(c)
+	if err != nil {
+		return &LintResult{Status: Error, Details: err.Error()}
 	}
-	return &LintResult{
-		Status: status,
+	// The BRs do not forbid the use of RSA-PSS as a signature scheme in
+	// certificates but it is not broadly supported by user-agents. Since
+	// the BRs do not forbid the practice we return a warning result.
+	// NOTE: The Mozilla root program policy *does* forbid their use since v2.7.
+	// This should be covered by a lint scoped to the Mozilla source instead of in
+	// this CABF lint.
+	if p.Discouraged {
+		return &LintResult{Status: Warn}
 	}
+	return &LintResult{Status: Pass}
 }
 
 func init() {
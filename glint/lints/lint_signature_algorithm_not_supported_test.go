@@ -44,3 +44,39 @@ func TestSignatureAlgorithmDSASha1Warn(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSignatureAlgorithmSHA1Subscriber(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSHA1Subscriber2020.pem"
+	expected := Error
+	out := Lints["e_signature_algorithm_not_supported"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSignatureAlgorithmMD5Subscriber(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgMD5Subscriber2020.pem"
+	expected := Error
+	out := Lints["e_signature_algorithm_not_supported"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSignatureAlgorithmSHA1TimestampBefore20220501(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSHA1TSA2022.pem"
+	expected := Pass
+	out := Lints["e_signature_algorithm_not_supported"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSignatureAlgorithmSHA1TimestampAfter20220501(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAlgSHA1TSA2023.pem"
+	expected := Error
+	out := Lints["e_signature_algorithm_not_supported"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,76 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3279: 2.2.1
+When any of these three OIDs appears within the ID AlgorithmIdentifier,
+the parameters component of that type SHALL be the ASN.1 type NULL.
+
+RFC 4055: 5
+When any of these four object identifiers appears within an
+AlgorithmIdentifier, the parameters MUST be NULL.
+
+RFC 5758: 3.2
+When the ecdsa-with-SHA224, ecdsa-with-SHA256, ecdsa-with-SHA384, or
+ecdsa-with-SHA512 algorithm identifier appears in the algorithm field
+as an AlgorithmIdentifier, the encoding MUST omit the parameters
+field.
+************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type signatureAlgorithmParametersIncorrect struct{}
+
+func (l *signatureAlgorithmParametersIncorrect) Initialize() error {
+	return nil
+}
+
+func (l *signatureAlgorithmParametersIncorrect) CheckApplies(c *x509.Certificate) bool {
+	tbs, outer, err := util.GetSignatureAlgorithms(c)
+	if err != nil {
+		return false
+	}
+	_, tbsKnown := util.SignatureParametersValid(tbs)
+	_, outerKnown := util.SignatureParametersValid(outer)
+	return tbsKnown || outerKnown
+}
+
+func (l *signatureAlgorithmParametersIncorrect) Execute(c *x509.Certificate) *LintResult {
+	tbs, outer, err := util.GetSignatureAlgorithms(c)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	for _, ai := range []*util.AlgorithmIdentifier{tbs, outer} {
+		if valid, known := util.SignatureParametersValid(ai); known && !valid {
+			return &LintResult{Status: Error}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_signature_algorithm_parameters_incorrect",
+		Description:   "Signature AlgorithmIdentifier parameters MUST be NULL for RSASSA-PKCS1-v1_5, MUST be omitted for ECDSA, DSA and EdDSA, and MUST be present for RSASSA-PSS.",
+		Citation:      "RFC 3279: 2.2, RFC 4055: 5, RFC 5758: 3",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &signatureAlgorithmParametersIncorrect{},
+	})
+}
@@ -0,0 +1,80 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3279: 2.3.1
+The rsaEncryption OID is intended to be used in the algorithm field
+of a value of type AlgorithmIdentifier. The parameters field MUST
+have ASN.1 type NULL for this algorithm identifier.
+
+RFC 5480: 2.1.1
+The parameter for id-ecPublicKey is as follows and MUST always be
+present:
+
+  ECParameters ::= CHOICE {
+    namedCurve         OBJECT IDENTIFIER
+    -- implicitCurve   NULL
+    -- specifiedCurve  SpecifiedECDomain
+  }
+  ...
+  -- implicitCurve and specifiedCurve MUST NOT be used in PKIX.
+************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type spkiAlgorithmParametersIncorrect struct{}
+
+func (l *spkiAlgorithmParametersIncorrect) Initialize() error {
+	return nil
+}
+
+func (l *spkiAlgorithmParametersIncorrect) CheckApplies(c *x509.Certificate) bool {
+	ai, err := util.GetPublicKeyAlgorithm(c)
+	if err != nil {
+		return false
+	}
+	return ai.Algorithm.Equal(util.RSAEncryptionOID) || ai.Algorithm.Equal(util.ECPublicKeyOID)
+}
+
+func (l *spkiAlgorithmParametersIncorrect) Execute(c *x509.Certificate) *LintResult {
+	ai, err := util.GetPublicKeyAlgorithm(c)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if ai.Algorithm.Equal(util.RSAEncryptionOID) && !ai.HasNullParameters() {
+		return &LintResult{Status: Error, Details: "rsaEncryption parameters are not NULL"}
+	}
+	if ai.Algorithm.Equal(util.ECPublicKeyOID) && ai.Parameters.Tag != asn1.TagOID {
+		return &LintResult{Status: Error, Details: "id-ecPublicKey parameters are not a namedCurve"}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_spki_algorithm_parameters_incorrect",
+		Description:   "subjectPublicKeyInfo parameters MUST be NULL for rsaEncryption and MUST be a namedCurve for id-ecPublicKey.",
+		Citation:      "RFC 3279: 2.3.1, RFC 5480: 2.1.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &spkiAlgorithmParametersIncorrect{},
+	})
+}
@@ -0,0 +1,54 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.2.a certificatePolicies
+	This extension MUST be present and SHOULD NOT be marked critical.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCACertPolicyCrit struct{}
+
+func (l *subCACertPolicyCrit) Initialize() error {
+	return nil
+}
+
+func (l *subCACertPolicyCrit) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *subCACertPolicyCrit) Execute(c *x509.Certificate) *LintResult {
+	if e := util.GetExtFromCert(c, util.CertPolicyOID); !e.Critical {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Warn}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_sub_ca_certificate_policies_marked_critical",
+		Description:   "Subordinate CA Certificate: certificatePolicies SHOULD NOT be marked critical.",
+		Citation:      "BRs: 7.1.2.2.a",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCACertPolicyCrit{},
+	})
+}
@@ -0,0 +1,55 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.2.b cRLDistributionPoints
+	This extension MUST be present and MUST NOT be marked critical. It MUST
+	contain the HTTP URL of the CA's CRL service.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCACrlDistMissing struct{}
+
+func (l *subCACrlDistMissing) Initialize() error {
+	return nil
+}
+
+func (l *subCACrlDistMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c)
+}
+
+func (l *subCACrlDistMissing) Execute(c *x509.Certificate) *LintResult {
+	if util.IsExtInCert(c, util.CrlDistOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_ca_crl_distribution_points_missing",
+		Description:   "Subordinate CA Certificate: cRLDistributionPoints MUST be present.",
+		Citation:      "BRs: 7.1.2.2.b",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCACrlDistMissing{},
+	})
+}
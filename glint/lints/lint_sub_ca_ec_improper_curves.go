@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.1.5.1 Root CA and Subordinate CA Key Sizes
+	For Keys corresponding to Root and Subordinate CA Certificates:
+		• If the Key is RSA, then the modulus MUST be at least 4096 bits in length.
+		• If the Key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.
+******************************************************************************/
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCAEcdsaImproperCurves struct{}
+
+func (l *subCAEcdsaImproperCurves) Initialize() error {
+	return nil
+}
+
+func (l *subCAEcdsaImproperCurves) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithm == x509.ECDSA && util.IsSubCA(c)
+}
+
+func (l *subCAEcdsaImproperCurves) Execute(c *x509.Certificate) *LintResult {
+	var key *ecdsa.PublicKey
+	switch k := c.PublicKey.(type) {
+	case *x509.AugmentedECDSA:
+		key = k.Pub
+	case *ecdsa.PublicKey:
+		key = k
+	default:
+		return &LintResult{Status: Fatal}
+	}
+	switch key.Curve.Params().Name {
+	case "P-256", "P-384", "P-521":
+		return &LintResult{Status: Pass}
+	default:
+		return &LintResult{Status: Error}
+	}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_ca_ec_improper_curves",
+		Description:   "Subordinate CA Certificate: If the key is ECDSA, then the curve MUST be one of NIST P-256, P-384, or P-521.",
+		Citation:      "BRs: 6.1.5.1",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.BRfCSCV21MinCryptoEffectiveDate,
+		Lint:          &subCAEcdsaImproperCurves{},
+	})
+}
@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.2.g extKeyUsage
+	If the Subordinate CA will be used to issue Code Signing Certificates, then
+	id-kp-codeSigning MUST be present and the following EKUs MAY be present:
+		•	Lifetime Signing OID (1.3.6.1.4.1.311.10.3.13)
+		•	id-kp-emailProtection
+		•	Document Signing (1.3.6.1.4.1.311.3.10.3.12)
+	If the Subordinate CA will be used to issue Timestamp Certificates, then
+	id-kp-timeStamping MUST be present.
+	Additionally, the following EKUs MUST NOT be present:
+		•	anyExtendedKeyUsage
+		•	id-kp-serverAuth
+	Other values SHOULD NOT be present.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCAEkuMissing struct{}
+
+func (l *subCAEkuMissing) Initialize() error {
+	return nil
+}
+
+func (l *subCAEkuMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c)
+}
+
+func (l *subCAEkuMissing) Execute(c *x509.Certificate) *LintResult {
+	if util.IsExtInCert(c, util.EkuSynOid) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_ca_eku_missing",
+		Description:   "Subordinate CA Certificate: extKeyUsage MUST be present, with id-kp-codeSigning or id-kp-timeStamping.",
+		Citation:      "BRs: 7.1.2.2.g",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCAEkuMissing{},
+	})
+}
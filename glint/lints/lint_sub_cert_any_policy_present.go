@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.3.a certificatePolicies
+	certificatePolicies:policyIdentifier (Required)
+		The Reserved Certificate Policy Identifier, as specified in Section
+		7.1.6.1.
+
+RFC 5280: 4.2.1.4
+	When a CA does not wish to limit the set of policies for certification
+	paths that include this certificate, it may assert the special policy
+	anyPolicy, with a value of { 2 5 29 32 0 }.
+
+anyPolicy in a Subscriber Certificate names no policy the certificate was
+issued under.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertAnyPolicyPresent struct{}
+
+func (l *subCertAnyPolicyPresent) Initialize() error {
+	return nil
+}
+
+func (l *subCertAnyPolicyPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *subCertAnyPolicyPresent) Execute(c *x509.Certificate) *LintResult {
+	if util.SliceContainsOID(c.PolicyIdentifiers, util.AnyPolicyOID) {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_sub_cert_any_policy_present",
+		Description:   "Subscriber Certificates should assert the policies they were issued under, not anyPolicy.",
+		Citation:      "BRfCSC v3.4: 7.1.2.3.a",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &subCertAnyPolicyPresent{},
+	})
+}
@@ -0,0 +1,68 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.3.a certificatePolicies
+	certificatePolicies:policyIdentifier (Required)
+		The Reserved Certificate Policy Identifier, as specified in Section
+		7.1.6.1.
+
+7.1.6.1 Reserved Certificate Policy Identifiers
+	{joint-iso-itu-t(2) international-organizations(23) ca-browser-forum(140)
+	certificate-policies(1) code-signing-requirements(4) code-signing(1)}
+	(2.23.140.1.4.1)
+
+	If the Certificate complies with these Requirements and has been issued
+	and operated in accordance with the CA/Browser Forum Guidelines for the
+	Issuance and Management of Extended Validation Certificates, the CA SHALL
+	include 2.23.140.1.3 instead.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertCodeSigningPolicyOIDEVAndNonEV struct{}
+
+func (l *subCertCodeSigningPolicyOIDEVAndNonEV) Initialize() error {
+	return nil
+}
+
+func (l *subCertCodeSigningPolicyOIDEVAndNonEV) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.SliceContainsOID(c.PolicyIdentifiers, util.BRAssertComplianceEVOID)
+}
+
+func (l *subCertCodeSigningPolicyOIDEVAndNonEV) Execute(c *x509.Certificate) *LintResult {
+	// An EV Code Signing Certificate asserts 2.23.140.1.3 instead of, not in
+	// addition to, the non-EV policy OID.
+	if util.SliceContainsOID(c.PolicyIdentifiers, util.BRAssertComplianceOID) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_cert_code_signing_policy_oid_ev_and_non_ev",
+		Description:   "Code Signing Certificates MUST NOT assert both the non-EV (2.23.140.1.4.1) and the EV (2.23.140.1.3) reserved policy OIDs.",
+		Citation:      "BRfCSC v3.4: 7.1.6.1",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &subCertCodeSigningPolicyOIDEVAndNonEV{},
+	})
+}
@@ -0,0 +1,66 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.3.a certificatePolicies
+	certificatePolicies:policyIdentifier (Required)
+		The Reserved Certificate Policy Identifier, as specified in Section
+		7.1.6.1.
+
+7.1.6.1 Reserved Certificate Policy Identifiers
+	{joint-iso-itu-t(2) international-organizations(23) ca-browser-forum(140)
+	certificate-policies(1) code-signing-requirements(4) code-signing(1)}
+	(2.23.140.1.4.1)
+
+	If the Certificate complies with these Requirements and has been issued
+	and operated in accordance with the CA/Browser Forum Guidelines for the
+	Issuance and Management of Extended Validation Certificates, the CA SHALL
+	include 2.23.140.1.3 instead.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertCodeSigningPolicyOIDMissing struct{}
+
+func (l *subCertCodeSigningPolicyOIDMissing) Initialize() error {
+	return nil
+}
+
+func (l *subCertCodeSigningPolicyOIDMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsCodeSigningCert(c) && !util.IsTimestampCert(c)
+}
+
+func (l *subCertCodeSigningPolicyOIDMissing) Execute(c *x509.Certificate) *LintResult {
+	if util.SliceContainsOID(c.PolicyIdentifiers, util.BRAssertComplianceOID) || util.SliceContainsOID(c.PolicyIdentifiers, util.BRAssertComplianceEVOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_cert_code_signing_policy_oid_missing",
+		Description:   "Code Signing Certificates MUST assert the CA/Browser Forum reserved policy OID 2.23.140.1.4.1, or 2.23.140.1.3 for EV Code Signing Certificates.",
+		Citation:      "BRfCSC v3.4: 7.1.6.1",
+		Source:        BRfCSCV20,
+		EffectiveDate: util.BRfCSCV20EffectiveDate,
+		Lint:          &subCertCodeSigningPolicyOIDMissing{},
+	})
+}
@@ -48,7 +48,7 @@ func (l *subCertExtKeyUsageCodeSigningNotSet) Initialize() error {
 }
 
 func (l *subCertExtKeyUsageCodeSigningNotSet) CheckApplies(c *x509.Certificate) bool {
-	return util.IsSubscriberCert(c) && c.ExtKeyUsage != nil
+	return util.IsSubscriberCert(c) && !util.IsTimestampCert(c) && c.ExtKeyUsage != nil
 }
 
 func (l *subCertExtKeyUsageCodeSigningNotSet) Execute(c *x509.Certificate) *LintResult {
@@ -35,3 +35,21 @@ func TestEkuCodeSigningSet(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestEkuCodeSigningNotSetTimestampCert(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAValid.pem"
+	expected := NA
+	out := Lints["e_sub_cert_eku_code_signing_not_set"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEkuCodeSigningSetWithTimestamping(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAEkuCodeSigning.pem"
+	expected := Pass
+	out := Lints["e_sub_cert_eku_code_signing_not_set"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -48,7 +48,7 @@ func (l *subCertExtKeyUsageLegal) Initialize() error {
 }
 
 func (l *subCertExtKeyUsageLegal) CheckApplies(c *x509.Certificate) bool {
-	return util.IsSubscriberCert(c) && c.ExtKeyUsage != nil
+	return util.IsSubscriberCert(c) && !util.IsTimestampCert(c) && c.ExtKeyUsage != nil
 }
 
 func (l *subCertExtKeyUsageLegal) Execute(c *x509.Certificate) *LintResult {
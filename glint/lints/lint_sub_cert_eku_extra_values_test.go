@@ -44,3 +44,21 @@ func TestEkuUsageLegalWithAdditionalEKUs(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestEkuUsageLegalTimestampCert(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAValid.pem"
+	expected := NA
+	out := Lints["e_sub_cert_eku_usage_legal"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestEkuUsageLegalCodeSigningAndTimestamping(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAEkuCodeSigning.pem"
+	expected := Error
+	out := Lints["e_sub_cert_eku_usage_legal"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
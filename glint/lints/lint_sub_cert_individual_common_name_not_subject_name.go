@@ -0,0 +1,73 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4
+7.1.4.2.2 Subject distinguished name fields - EV and Non-EV Code Signing Certificates
+	Certificate Field: subject:commonName (OID 2.5.4.3)
+	Required/Optional: Required
+	Contents: This field MUST contain the Subject’s legal name as verified under BR Section 3.2.
+******************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertIndividualCommonNameNotSubjectName struct{}
+
+func (l *subCertIndividualCommonNameNotSubjectName) Initialize() error {
+	return nil
+}
+
+func (l *subCertIndividualCommonNameNotSubjectName) CheckApplies(c *x509.Certificate) bool {
+	return util.IsIndividualCodeSigningCert(c) && c.Subject.CommonName != ""
+}
+
+func (l *subCertIndividualCommonNameNotSubjectName) Execute(c *x509.Certificate) *LintResult {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(s), " "))
+	}
+	var names []string
+	for _, given := range c.Subject.GivenName {
+		names = append(names, given)
+		for _, surname := range c.Subject.Surname {
+			names = append(names, given+" "+surname, surname+" "+given, surname+", "+given)
+		}
+	}
+	names = append(names, c.Subject.Surname...)
+	cn := normalize(c.Subject.CommonName)
+	for _, name := range names {
+		if normalize(name) == cn {
+			return &LintResult{Status: Pass}
+		}
+	}
+	return &LintResult{Status: Warn, Details: fmt.Sprintf("commonName %q is not the name given in givenName and surname", c.Subject.CommonName)}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_sub_cert_individual_common_name_not_subject_name",
+		Description:   "Subscriber Certificate: the commonName of a certificate issued to a natural person should be the Subject’s name as given in givenName and surname.",
+		Citation:      "BRs: 7.1.4.2.2.a",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCertIndividualCommonNameNotSubjectName{},
+	})
+}
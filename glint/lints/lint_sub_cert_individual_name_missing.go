@@ -0,0 +1,68 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+MRfCSC: 9.2.4.a
+Certificate Field: subject:organizationName (OID 2.5.4.10)
+Contents: ... Because subject name attributes for individuals
+	(e.g. givenName (2.5.4.42) and surname (2.5.4.4)) are not broadly
+	supported by application software, the CA MAY use the
+	subject:organizationName field to convey a natural person Subject’s
+	name or DBA.
+
+Baseline Requirements: 7.1.4.2.2.c
+Certificate Field: subject:givenName (2.5.4.42) and subject:surname (2.5.4.4)
+Required/Optional: Optional
+Contents: If present, the subject:givenName field and subject:surname field
+	MUST contain a natural person Subject’s name as verified under Section
+	3.2.3.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertIndividualNameMissing struct{}
+
+func (l *subCertIndividualNameMissing) Initialize() error {
+	return nil
+}
+
+// CheckApplies selects the certificates that identify as issued to a
+// natural person. A certificate with neither a name nor organizationName is
+// left to e_subject_organization_name_missing.
+func (l *subCertIndividualNameMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsIndividualCodeSigningCert(c)
+}
+
+func (l *subCertIndividualNameMissing) Execute(c *x509.Certificate) *LintResult {
+	if len(c.Subject.GivenName) > 0 || len(c.Subject.Surname) > 0 {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_cert_individual_name_missing",
+		Description:   "Subscriber Certificate: a certificate issued to a natural person MUST name the Subject in givenName and/or surname.",
+		Citation:      "BRs: 7.1.4.2.2.c",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCertIndividualNameMissing{},
+	})
+}
@@ -0,0 +1,62 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestSubCertIndividualNameMissingNoName(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVNoName.pem"
+	expected := NA
+	out := Lints["e_sub_cert_individual_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubCertIndividualNameMissingGivenNameSurname(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVValid.pem"
+	expected := Pass
+	out := Lints["e_sub_cert_individual_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubCertIndividualNameMissingOrganizationName(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVOrgName.pem"
+	expected := NA
+	out := Lints["e_sub_cert_individual_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubCertIndividualNameMissingOrganization(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVOrganization.pem"
+	expected := NA
+	out := Lints["e_sub_cert_individual_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubCertIndividualNameMissingIndividualValidated(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVIndividualValidatedNoName.pem"
+	expected := Error
+	out := Lints["e_sub_cert_individual_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
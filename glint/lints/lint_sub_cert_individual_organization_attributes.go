@@ -0,0 +1,67 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+CA-Browser Forum EV Guidelines
+v1.7.3: 9.2.3, 9.2.4, 9.2.5
+	The businessCategory, jurisdiction and organizationIdentifier fields
+	describe the Private Organization, Government Entity, Business Entity or
+	Non-Commercial Entity that the Subject of an EV Certificate is.
+
+Baseline Requirements: 7.1.4.2.2.j
+	Optional attributes, when present within the subject field, MUST contain
+	information that has been verified by the CA.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertIndividualOrganizationAttributes struct{}
+
+func (l *subCertIndividualOrganizationAttributes) Initialize() error {
+	return nil
+}
+
+func (l *subCertIndividualOrganizationAttributes) CheckApplies(c *x509.Certificate) bool {
+	return util.IsIndividualCodeSigningCert(c)
+}
+
+func (l *subCertIndividualOrganizationAttributes) Execute(c *x509.Certificate) *LintResult {
+	switch {
+	case util.GetBusinessCategory(c) != "":
+		return &LintResult{Status: Warn, Details: "subject contains businessCategory"}
+	case len(c.Subject.JurisdictionCountry) > 0 || len(c.Subject.JurisdictionProvince) > 0 || len(c.Subject.JurisdictionLocality) > 0:
+		return &LintResult{Status: Warn, Details: "subject contains jurisdiction of incorporation or registration fields"}
+	case len(c.Subject.OrganizationIDs) > 0:
+		return &LintResult{Status: Warn, Details: fmt.Sprintf("subject contains organizationIdentifier %q", c.Subject.OrganizationIDs[0])}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_sub_cert_individual_organization_attributes",
+		Description:   "Subscriber Certificate: a certificate issued to a natural person should not contain the businessCategory, jurisdiction or organizationIdentifier fields, which describe a legal entity.",
+		Citation:      "BRs: 7.1.4.2.2.j",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCertIndividualOrganizationAttributes{},
+	})
+}
@@ -0,0 +1,71 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.5
+	In some situations, devices are given certificates for which no good
+	expiration date can be assigned.  For example, a device could be issued
+	a certificate that binds its model and serial number to its public key;
+	such a certificate is intended to be used for the entire lifetime of the
+	device.
+
+	To indicate that a certificate has no well-defined expiration date,
+	the notAfter SHOULD be assigned the GeneralizedTime value of
+	99991231235959Z.
+
+BRfCSC v3.4:
+6.3.2 Certificate operational periods and key pair usage periods
+	The validity period for a Code Signing Certificate issued to a Subscriber or
+	Signing Service MUST NOT exceed 39 months.
+	...
+	The validity for a Timestamp Certificate MUST NOT exceed 135 months.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertNoWellDefinedExpiration struct{}
+
+func (l *subCertNoWellDefinedExpiration) Initialize() error {
+	return nil
+}
+
+func (l *subCertNoWellDefinedExpiration) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *subCertNoWellDefinedExpiration) Execute(c *x509.Certificate) *LintResult {
+	_, notAfter := util.GetTimes(c)
+	if notAfter.Tag == asn1.TagGeneralizedTime && string(notAfter.Bytes) == util.NoWellDefinedExpiration {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_sub_cert_no_well_defined_expiration",
+		Description:   "Subscriber and Timestamp Certificates MUST NOT use the notAfter value 99991231235959Z, which means the certificate never expires.",
+		Citation:      "BRs: 6.3.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subCertNoWellDefinedExpiration{},
+	})
+}
@@ -45,7 +45,7 @@ func (l *subCertRsaModSizeOld) CheckApplies(c *x509.Certificate) bool {
 
 func (l *subCertRsaModSizeOld) Execute(c *x509.Certificate) *LintResult {
 	key := c.PublicKey.(*rsa.PublicKey)
-	if key.N.BitLen() < 2048 {
+	if key.N.BitLen() < util.GetMinKeyBits(x509.RSA, util.GetAlgorithmRole(c), c.NotBefore) {
 		return &LintResult{Status: Error}
 	} else {
 		return &LintResult{Status: Pass}
@@ -45,12 +45,12 @@ func (l *subCertRsaModSize) Initialize() error {
 
 func (l *subCertRsaModSize) CheckApplies(c *x509.Certificate) bool {
 	_, ok := c.PublicKey.(*rsa.PublicKey)
-	return ok && c.PublicKeyAlgorithm == x509.RSA && util.IsSubscriberCert(c)
+	return ok && c.PublicKeyAlgorithm == x509.RSA && util.IsSubscriberCert(c) && !util.IsTimestampCert(c)
 }
 
 func (l *subCertRsaModSize) Execute(c *x509.Certificate) *LintResult {
 	key := c.PublicKey.(*rsa.PublicKey)
-	if key.N.BitLen() < 3072 {
+	if key.N.BitLen() < util.GetMinKeyBits(x509.RSA, util.GetAlgorithmRole(c), c.NotBefore) {
 		return &LintResult{Status: Error}
 	} else {
 		return &LintResult{Status: Pass}
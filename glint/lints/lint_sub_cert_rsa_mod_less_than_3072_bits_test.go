@@ -53,3 +53,12 @@ func TestRsaModSizeTooSmallBeforeEffectiveDate(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSubCertRsaModCodeSigningAndTimestamping(t *testing.T) {
+	inputPath := "../testlint/testCerts/csTSAEkuCodeSigning.pem"
+	expected := Error
+	out := Lints["e_rsa_mod_less_than_3072_bits"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
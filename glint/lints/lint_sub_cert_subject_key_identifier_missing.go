@@ -0,0 +1,60 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.2.1.2
+	For end entity certificates, the subject key identifier extension
+	provides a means for identifying certificates containing the
+	particular public key used in an application.  Where an end entity has
+	obtained multiple certificates, especially from multiple CAs, the
+	subject key identifier provides a means to quickly identify the set
+	of certificates containing a particular public key.  To assist
+	applications in identifying the appropriate end entity certificate,
+	this extension SHOULD be included in all end entity certificates.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subCertSkiMissing struct{}
+
+func (l *subCertSkiMissing) Initialize() error {
+	return nil
+}
+
+func (l *subCertSkiMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *subCertSkiMissing) Execute(c *x509.Certificate) *LintResult {
+	if util.IsExtInCert(c, util.SubjectKeyIdentityOID) {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Warn}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_sub_cert_subject_key_identifier_missing",
+		Description:   "Subscriber Certificate: subjectKeyIdentifier SHOULD be included in all end entity certificates.",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &subCertSkiMissing{},
+	})
+}
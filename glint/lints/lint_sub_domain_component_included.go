@@ -60,7 +60,7 @@ func (l *subjectDomainComponent) Execute(c *x509.Certificate) *LintResult {
 		if len(c.Subject.DomainComponent) == 0 {
 			return &LintResult{Status: Pass}
 		} else {
-			if util.IsEV(c.PolicyIdentifiers) {
+			if util.IsEVCert(c) {
 				return &LintResult{Status: Pass}
 			} else {
 				return &LintResult{Status: Error}
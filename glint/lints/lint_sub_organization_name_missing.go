@@ -47,7 +47,8 @@ func (l *subjectOrganizationName) Initialize() error {
 }
 
 func (l *subjectOrganizationName) CheckApplies(c *x509.Certificate) bool {
-	return util.IsSubscriberCert(c)
+	// A natural person may be named with givenName and surname instead.
+	return util.IsSubscriberCert(c) && !util.IsIndividualCodeSigningCert(c)
 }
 
 func (l *subjectOrganizationName) Execute(c *x509.Certificate) *LintResult {
@@ -61,7 +62,7 @@ func (l *subjectOrganizationName) Execute(c *x509.Certificate) *LintResult {
 func init() {
 	RegisterLint(&Lint{
 		Name:          "e_subject_organization_name_missing",
-		Description:   "Subscriber Certificate: organizationName is required.",
+		Description:   "Subscriber Certificate: organizationName is required, unless the Subject is a natural person.",
 		Citation:      "MRfCSC: 9.2.4.a",
 		Source:        MinimumRequirementsForCodeSigningCertificates,
 		EffectiveDate: util.MRfCSCEffectiveDate,
@@ -35,3 +35,30 @@ func TestSubjectOrganizationNamePresent(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSubjectOrganizationNameIndividual(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVValid.pem"
+	expected := NA
+	out := Lints["e_subject_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectOrganizationNameIndividualNoPolicy(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVNoPolicy.pem"
+	expected := NA
+	out := Lints["e_subject_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectOrganizationNameIndividualNoName(t *testing.T) {
+	inputPath := "../testlint/testCerts/csIVNoName.pem"
+	expected := Error
+	out := Lints["e_subject_organization_name_missing"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
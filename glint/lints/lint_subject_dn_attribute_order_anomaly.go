@@ -0,0 +1,57 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Names are conventionally written from the most general attribute to the
+	most specific, countryName, stateOrProvinceName, localityName,
+	organizationName, organizationalUnitName, commonName, or in the reverse
+	order. A name in any other order is likely assembled by hand and
+	displays differently from names of the same entity.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNAttributeOrderAnomaly struct{}
+
+func (l *subjectDNAttributeOrderAnomaly) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNAttributeOrderAnomaly) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNAttributeOrderAnomaly) Execute(c *x509.Certificate) *LintResult {
+	if !util.NameAttributesOrdered(c.Subject.ToRDNSequence()) {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_dn_attribute_order_anomaly",
+		Description:   "Subject name attributes should appear in the conventional order, C, ST, L, O, OU, CN, or its reverse.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNAttributeOrderAnomaly{},
+	})
+}
@@ -0,0 +1,82 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: Appendix A.1
+	ub-common-name INTEGER ::= 64
+	ub-locality-name INTEGER ::= 128
+	ub-state-name INTEGER ::= 128
+	ub-organization-name INTEGER ::= 64
+	ub-organizational-unit-name INTEGER ::= 64
+	ub-title INTEGER ::= 64
+	ub-serial-number INTEGER ::= 64
+	ub-pseudonym INTEGER ::= 128
+	ub-emailaddress-length INTEGER ::= 255
+
+X.520 also bounds streetAddress and businessCategory to 128 characters and
+postalCode to 40.
+******************************************************************************/
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNAttributeTooLong struct{}
+
+func (l *subjectDNAttributeTooLong) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNAttributeTooLong) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNAttributeTooLong) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		bound, ok := util.AttributeUpperBound(atv.Type)
+		if !ok {
+			continue
+		}
+		value, err := util.DecodeDirectoryString(atv.Value)
+		if err != nil {
+			// Reported by e_subject_dn_string_invalid_encoding.
+			continue
+		}
+		if n := utf8.RuneCountInString(value); n > bound.Max {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s is %d characters long, the upper bound is %d", bound.Name, n, bound.Max)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_attribute_too_long",
+		Description:   "Subject attribute values MUST NOT exceed the upper bounds of RFC 5280 and X.520, e.g. 64 characters for commonName and organizationName.",
+		Citation:      "RFC 5280: Appendix A.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectDNAttributeTooLong{},
+	})
+}
@@ -0,0 +1,71 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Control characters (U+0001 to U+001F and U+007F to U+009F) in a subject
+	attribute value are not displayed, or are interpreted by terminals, so
+	the name shown to a relying party differs from the one certified. NUL is
+	reported by e_subject_dn_embedded_null.
+******************************************************************************/
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNControlCharacters struct{}
+
+func (l *subjectDNControlCharacters) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNControlCharacters) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNControlCharacters) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		value, err := util.DecodeDirectoryString(atv.Value)
+		if err != nil {
+			continue
+		}
+		for _, r := range value {
+			if r != 0 && unicode.IsControl(r) {
+				return &LintResult{Status: Error, Details: fmt.Sprintf("U+%04X in %s", r, atv.Type)}
+			}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_control_characters",
+		Description:   "Subject attribute values MUST NOT contain control characters.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNControlCharacters{},
+	})
+}
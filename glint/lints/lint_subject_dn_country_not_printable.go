@@ -0,0 +1,66 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: Appendix A.1
+	X520countryName ::=     PrintableString (SIZE (2))
+
+EV Guidelines v1.7.8: 9.2.4
+	jurisdictionCountryName ... ASN.1 - X520countryName as specified in RFC
+	5280
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNCountryNotPrintable struct{}
+
+func (l *subjectDNCountryNotPrintable) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNCountryNotPrintable) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNCountryNotPrintable) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		if util.IsCountryNameAttribute(atv.Type) && atv.Value.Tag != asn1.TagPrintableString {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s has tag %d", atv.Type, atv.Value.Tag)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_country_not_printable",
+		Description:   "The countryName and jurisdictionCountryName attributes of the subject MUST be encoded as PrintableString.",
+		Citation:      "RFC 5280: Appendix A.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectDNCountryNotPrintable{},
+	})
+}
@@ -0,0 +1,68 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	A NUL character in a subject attribute value truncates it in software
+	that handles names as C strings, so that e.g. "Good Corp\x00Evil Corp"
+	is displayed as "Good Corp".
+******************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNEmbeddedNull struct{}
+
+func (l *subjectDNEmbeddedNull) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNEmbeddedNull) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNEmbeddedNull) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		value, err := util.DecodeDirectoryString(atv.Value)
+		if err != nil {
+			continue
+		}
+		if strings.ContainsRune(value, 0) {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("NUL in %s", atv.Type)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_embedded_null",
+		Description:   "Subject attribute values MUST NOT contain NUL characters.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNEmbeddedNull{},
+	})
+}
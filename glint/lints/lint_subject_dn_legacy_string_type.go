@@ -0,0 +1,83 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.4
+	CAs conforming to this profile MUST use either the PrintableString or
+	UTF8String encoding of DirectoryString, with two exceptions. When CAs have
+	previously issued certificates with issuer fields with attributes encoded
+	using TeletexString, BMPString, or UniversalString, then the CA MAY
+	continue to use these encodings of the DirectoryString to preserve
+	backward compatibility. Also, new CAs that are added to a domain where
+	existing CAs issue certificates with issuer fields with attributes encoded
+	using TeletexString, BMPString, or UniversalString MAY encode attributes
+	that they share with the existing CAs using the same encodings as the
+	existing CAs use.
+
+Effective 2020-09-30 the Subject and Issuer Distinguished Names of newly
+issued Certificates are held to the byte-for-byte encoding rules of BRs
+7.1.4.1, which leaves no room for the legacy encodings.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNLegacyStringType struct{}
+
+func (l *subjectDNLegacyStringType) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNLegacyStringType) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNLegacyStringType) Execute(c *x509.Certificate) *LintResult {
+	return checkSubjectDNStringTypes(c, Error)
+}
+
+// checkSubjectDNStringTypes returns status if an attribute of the subject of
+// c is encoded as TeletexString, BMPString or UniversalString.
+func checkSubjectDNStringTypes(c *x509.Certificate, status LintStatus) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		switch atv.Value.Tag {
+		case asn1.TagT61String, asn1.TagBMPString, 28:
+			// UniversalString has no constant in encoding/asn1.
+			return &LintResult{Status: status, Details: fmt.Sprintf("%s has tag %d", atv.Type, atv.Value.Tag)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_legacy_string_type",
+		Description:   "Subject attributes of Certificates issued on or after 2020-09-30 MUST be encoded as PrintableString or UTF8String, not TeletexString, BMPString or UniversalString.",
+		Citation:      "RFC 5280: 4.1.2.4",
+		Source:        RFC5280,
+		EffectiveDate: util.NameEncodingChange,
+		Lint:          &subjectDNLegacyStringType{},
+	})
+}
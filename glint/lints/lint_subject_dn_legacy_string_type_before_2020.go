@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.4
+	CAs conforming to this profile MUST use either the PrintableString or
+	UTF8String encoding of DirectoryString, with two exceptions. When CAs have
+	previously issued certificates with issuer fields with attributes encoded
+	using TeletexString, BMPString, or UniversalString, then the CA MAY
+	continue to use these encodings of the DirectoryString to preserve
+	backward compatibility.
+
+Before 2020-09-30 a CA may have relied on this exception, so the legacy
+encodings are only reported as a warning.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNLegacyStringTypeBefore2020 struct{}
+
+func (l *subjectDNLegacyStringTypeBefore2020) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNLegacyStringTypeBefore2020) CheckApplies(c *x509.Certificate) bool {
+	return util.NameEncodingChange.After(c.NotBefore)
+}
+
+func (l *subjectDNLegacyStringTypeBefore2020) Execute(c *x509.Certificate) *LintResult {
+	return checkSubjectDNStringTypes(c, Warn)
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_dn_legacy_string_type",
+		Description:   "Subject attributes of Certificates issued before 2020-09-30 should be encoded as PrintableString or UTF8String; TeletexString, BMPString and UniversalString are only tolerated for backward compatibility.",
+		Citation:      "RFC 5280: 4.1.2.4",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &subjectDNLegacyStringTypeBefore2020{},
+	})
+}
@@ -0,0 +1,57 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Each RelativeDistinguishedName should hold a single attribute. The
+	attributes of a multi-valued RDN form a SET, which has no order, so
+	software renders them inconsistently.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNMultiValuedRDN struct{}
+
+func (l *subjectDNMultiValuedRDN) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNMultiValuedRDN) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNMultiValuedRDN) Execute(c *x509.Certificate) *LintResult {
+	if rdns := util.MultiValuedRDNs(c.Subject.ToRDNSequence()); len(rdns) > 0 {
+		return &LintResult{Status: Warn, Details: fmt.Sprintf("%d multi-valued RDNs", len(rdns))}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_dn_multi_valued_rdn",
+		Description:   "Each RDN of the subject name should contain a single attribute.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNMultiValuedRDN{},
+	})
+}
@@ -0,0 +1,61 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: Appendix A.1
+	X520SerialNumber ::=    PrintableString (SIZE (1..ub-serial-number))
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNSerialNumberNotPrintable struct{}
+
+func (l *subjectDNSerialNumberNotPrintable) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNSerialNumberNotPrintable) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNSerialNumberNotPrintable) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		if atv.Type.Equal(util.SerialOID) && atv.Value.Tag != asn1.TagPrintableString {
+			return &LintResult{Status: Error}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_serial_number_not_printable",
+		Description:   "The serialNumber attribute of the subject MUST be encoded as PrintableString.",
+		Citation:      "RFC 5280: Appendix A.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectDNSerialNumberNotPrintable{},
+	})
+}
@@ -0,0 +1,73 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.4
+	DirectoryString ::= CHOICE {
+		teletexString           TeletexString (SIZE (1..MAX)),
+		printableString         PrintableString (SIZE (1..MAX)),
+		universalString         UniversalString (SIZE (1..MAX)),
+		utf8String              UTF8String (SIZE (1..MAX)),
+		bmpString               BMPString (SIZE (1..MAX)) }
+
+The bytes of each string MUST be valid for its type, e.g. only the
+characters of X.680 41.4 in a PrintableString and well-formed UTF-8 in a
+UTF8String.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNStringInvalidEncoding struct{}
+
+func (l *subjectDNStringInvalidEncoding) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNStringInvalidEncoding) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNStringInvalidEncoding) Execute(c *x509.Certificate) *LintResult {
+	rdns, err := util.ParseRawRDNSequence(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	for _, atv := range rdns.Attributes() {
+		if !util.IsDirectoryString(atv.Value) {
+			continue
+		}
+		if _, err := util.DecodeDirectoryString(atv.Value); err != nil {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s: %s", atv.Type, err)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_dn_string_invalid_encoding",
+		Description:   "Subject attribute values MUST be valid for their string type.",
+		Citation:      "RFC 5280: 4.1.2.4",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectDNStringInvalidEncoding{},
+	})
+}
@@ -0,0 +1,59 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Subject attribute values should not begin or end with whitespace, which
+	is invisible when the name is displayed and makes names that look the
+	same compare as different.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNTrailingWhitespace struct{}
+
+func (l *subjectDNTrailingWhitespace) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNTrailingWhitespace) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNTrailingWhitespace) Execute(c *x509.Certificate) *LintResult {
+	_, trailing, err := util.CheckRDNSequenceWhiteSpace(c.RawSubject)
+	if err != nil {
+		return &LintResult{Status: Fatal}
+	}
+	if trailing {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_dn_trailing_whitespace",
+		Description:   "Subject attribute values should not have trailing whitespace.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNTrailingWhitespace{},
+	})
+}
@@ -0,0 +1,62 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+AWSLabs certlint
+	Subject names should only contain the attributes defined for PKIX names
+	in RFC 5280 and those added by the CA/Browser Forum requirements, such as
+	businessCategory and the EV jurisdiction attributes. Other attributes
+	are not verified by the CA and display as bare OIDs.
+******************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectDNUnknownAttribute struct{}
+
+func (l *subjectDNUnknownAttribute) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNUnknownAttribute) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNUnknownAttribute) Execute(c *x509.Certificate) *LintResult {
+	for _, rdn := range c.Subject.ToRDNSequence() {
+		for _, atv := range rdn {
+			if !util.IsSubjectAttribute(atv.Type) {
+				return &LintResult{Status: Warn, Details: fmt.Sprintf("unknown attribute %s", atv.Type)}
+			}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_dn_unknown_attribute",
+		Description:   "Subject names should not contain attribute types other than the PKIX name attributes and those of the CA/Browser Forum requirements.",
+		Citation:      "AWSLabs certlint",
+		Source:        AWSLabs,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &subjectDNUnknownAttribute{},
+	})
+}
@@ -0,0 +1,84 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4: 7.1.4.2.2
+	Subject Distinguished Name Fields for Code Signing Certificates are as
+	stated in Section 7.1.4.2.2 d, e, f, g and h of the Baseline
+	Requirements.
+
+Baseline Requirements: 7.1.4.2.2
+f. Certificate Field: subject:stateOrProvinceName (OID: 2.5.4.8)
+	Contents: If present, the subject:stateOrProvinceName field MUST contain
+	the Subject’s state or province information as verified under Section
+	3.2.2.1.
+
+g. Certificate Field: subject:postalCode (OID: 2.5.4.17)
+	Contents: If present, the subject:postalCode field MUST contain the
+	Subject’s zip or postal information as verified under Section 3.2.2.1.
+
+h. Certificate Field: subject:countryName (OID: 2.5.4.6)
+	Contents: The subject:countryName MUST contain the two‐letter ISO 3166‐1
+	country code associated with the location of the Subject verified under
+	Section 3.2.2.3.
+******************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectPostalCodeFormat struct{}
+
+func (l *subjectPostalCodeFormat) Initialize() error {
+	return nil
+}
+
+func (l *subjectPostalCodeFormat) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsOrganizationCodeSigningCert(c) || len(c.Subject.PostalCode) == 0 || len(c.Subject.Country) != 1 {
+		return false
+	}
+	_, known := util.GetPostalCodeFormat(c.Subject.Country[0])
+	return known
+}
+
+func (l *subjectPostalCodeFormat) Execute(c *x509.Certificate) *LintResult {
+	country := c.Subject.Country[0]
+	format, _ := util.GetPostalCodeFormat(country)
+	for _, code := range c.Subject.PostalCode {
+		if format == nil {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("%s has no postal codes, but the subject contains %q", country, code)}
+		}
+		if !format.MatchString(strings.ToUpper(strings.TrimSpace(code))) {
+			return &LintResult{Status: Warn, Details: fmt.Sprintf("%q is not a postal code of %s", code, country)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "w_subject_postal_code_format",
+		Description:   "The subject:postalCode of an OV or EV code signing certificate should have the postal code format of the country in subject:countryName.",
+		Citation:      "BRs: 7.1.4.2.2.g",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &subjectPostalCodeFormat{},
+	})
+}
@@ -0,0 +1,62 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestSubjectStateNotInCountryWrongCountry(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrStateWrongCountry.pem"
+	expected := Warn
+	out := Lints["w_subject_state_not_in_country"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectStateNotInCountryValid(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrValid.pem"
+	expected := Pass
+	out := Lints["w_subject_state_not_in_country"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectStateNotInCountryCode(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrStateCode.pem"
+	expected := Pass
+	out := Lints["w_subject_state_not_in_country"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectStateNotInCountryEnglishName(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrGermany.pem"
+	expected := Pass
+	out := Lints["w_subject_state_not_in_country"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectStateNotInCountryExonym(t *testing.T) {
+	inputPath := "../testlint/testCerts/csAddrPostalNone.pem"
+	expected := Pass
+	out := Lints["w_subject_state_not_in_country"].Execute(ReadCertificate(inputPath))
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
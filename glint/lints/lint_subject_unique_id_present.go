@@ -0,0 +1,64 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.8
+	These fields MUST only appear if the version is 2 or 3 (Section 4.1.2.1).
+	These fields MUST NOT appear if the version is 1.  The subject and issuer
+	unique identifiers are present in the certificate to handle the
+	possibility of reuse of subject and/or issuer names over time.  This
+	profile RECOMMENDS that names not be reused for different entities and
+	that Internet certificates not make use of unique identifiers.  CAs
+	conforming to this profile MUST NOT generate certificates with unique
+	identifiers.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type subjectUniqueIDPresent struct{}
+
+func (l *subjectUniqueIDPresent) Initialize() error {
+	return nil
+}
+
+func (l *subjectUniqueIDPresent) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectUniqueIDPresent) Execute(c *x509.Certificate) *LintResult {
+	tbs, err := util.ParseTBSCertificate(c.RawTBSCertificate)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	if tbs.SubjectUniqueID != nil {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_subject_unique_id_present",
+		Description:   "CAs MUST NOT generate certificates with a subjectUniqueID.",
+		Citation:      "RFC 5280: 4.1.2.8",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &subjectUniqueIDPresent{},
+	})
+}
@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+7.1.2.3.f extKeyUsage
+	If the Certificate is a Timestamp Certificate, then id-kp-timeStamping MUST
+	be present and MUST be marked critical.
+	Additionally, the following EKUs MUST NOT be present:
+		•	anyExtendedKeyUsage
+		•	id-kp-serverAuth
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type tsaCertEkuNotCritical struct{}
+
+func (l *tsaCertEkuNotCritical) Initialize() error {
+	return nil
+}
+
+func (l *tsaCertEkuNotCritical) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c)
+}
+
+func (l *tsaCertEkuNotCritical) Execute(c *x509.Certificate) *LintResult {
+	if e := util.GetExtFromCert(c, util.EkuSynOid); e != nil && e.Critical {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_tsa_cert_eku_not_critical",
+		Description:   "Timestamp Certificate: extKeyUsage containing id-kp-timeStamping MUST be marked critical.",
+		Citation:      "BRs: 7.1.2.3.f",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &tsaCertEkuNotCritical{},
+	})
+}
@@ -0,0 +1,69 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 3161: 2.3
+	The TSA MUST sign each time-stamp message with a key reserved
+	specifically for that purpose. A TSA MAY have distinct private keys,
+	e.g., to accommodate different policies, different algorithms,
+	different private key sizes or to increase the performance. The
+	corresponding certificate MUST contain only one instance of the
+	extended key usage field extension as defined in [RFC2459] Section
+	4.2.1.13 with KeyPurposeID having value:
+
+	id-kp-timeStamping.  This extension MUST be critical.
+
+BRfCSC v3.4:
+7.1.2.3.f extKeyUsage
+	If the Certificate is a Timestamp Certificate, then id-kp-timeStamping MUST
+	be present and MUST be marked critical.
+	Additionally, the following EKUs MUST NOT be present:
+		•	anyExtendedKeyUsage
+		•	id-kp-serverAuth
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type tsaCertEkuNotExclusive struct{}
+
+func (l *tsaCertEkuNotExclusive) Initialize() error {
+	return nil
+}
+
+func (l *tsaCertEkuNotExclusive) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c)
+}
+
+func (l *tsaCertEkuNotExclusive) Execute(c *x509.Certificate) *LintResult {
+	if len(c.ExtKeyUsage) == 1 && len(c.UnknownExtKeyUsage) == 0 {
+		return &LintResult{Status: Pass}
+	}
+	return &LintResult{Status: Error}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_tsa_cert_eku_not_exclusive",
+		Description:   "Timestamp Certificate: extKeyUsage MUST contain id-kp-timeStamping as its only KeyPurposeID.",
+		Citation:      "RFC 3161: 2.3",
+		Source:        RFC3161,
+		EffectiveDate: util.RFC3161Date,
+		Lint:          &tsaCertEkuNotExclusive{},
+	})
+}
@@ -0,0 +1,59 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 3280: 4.2.1.4
+	The private key usage period extension allows the certificate issuer
+	to specify a different validity period for the private key than the
+	certificate. This extension is intended for use with digital
+	signature keys.
+	...
+	CAs conforming to this profile MUST NOT generate certificates with
+	critical private key usage period extensions.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type tsaCertPrivKeyUsagePeriodCritical struct{}
+
+func (l *tsaCertPrivKeyUsagePeriodCritical) Initialize() error {
+	return nil
+}
+
+func (l *tsaCertPrivKeyUsagePeriodCritical) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c) && util.IsExtInCert(c, util.PrivKeyUsageOID)
+}
+
+func (l *tsaCertPrivKeyUsagePeriodCritical) Execute(c *x509.Certificate) *LintResult {
+	if util.GetExtFromCert(c, util.PrivKeyUsageOID).Critical {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_tsa_cert_private_key_usage_period_critical",
+		Description:   "Timestamp Certificate: the Private Key Usage Period extension MUST NOT be marked critical.",
+		Citation:      "RFC 3280: 4.2.1.4",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &tsaCertPrivKeyUsagePeriodCritical{},
+	})
+}
@@ -0,0 +1,78 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.3.2 Certificate operational periods and key pair usage periods
+	The validity period for a Code Signing Certificate issued to a Subscriber or
+	Signing Service MUST NOT exceed 39 months.
+	The Timestamp Authority MUST use a new Timestamp Certificate with a new
+	private key no later than every 15 months to minimize the impact to users in
+	the event that a Timestamp Certificate's private key is compromised. The
+	validity for a Timestamp Certificate MUST NOT exceed 135 months.
+
+RFC 3280: 4.2.1.4
+	The private key usage period extension allows the certificate issuer
+	to specify a different validity period for the private key than the
+	certificate. This extension is intended for use with digital
+	signature keys.
+	...
+	CAs conforming to this profile MUST NOT generate certificates with
+	critical private key usage period extensions.
+******************************************************************************/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type tsaCertPrivKeyUsagePeriodTooLong struct{}
+
+func (l *tsaCertPrivKeyUsagePeriodTooLong) Initialize() error {
+	return nil
+}
+
+func (l *tsaCertPrivKeyUsagePeriodTooLong) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c) && util.IsExtInCert(c, util.PrivKeyUsageOID)
+}
+
+func (l *tsaCertPrivKeyUsagePeriodTooLong) Execute(c *x509.Certificate) *LintResult {
+	period, err := util.GetPrivateKeyUsagePeriod(c)
+	if err != nil {
+		return &LintResult{Status: Fatal, Details: err.Error()}
+	}
+	// The key may only be used while both the certificate and the private
+	// key usage period are in effect.
+	notBefore, notAfter := period.SigningWindow(c)
+	if notBefore.AddDate(0, 15, 0).Before(notAfter) {
+		return &LintResult{Status: Error, Details: fmt.Sprintf("private key may be used from %s to %s", notBefore.Format(time.RFC3339), notAfter.Format(time.RFC3339))}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_tsa_cert_private_key_usage_period_longer_than_15_months",
+		Description:   "Timestamp Certificate: the private key MUST NOT be used for more than 15 months, so the Private Key Usage Period, within the validity period, MUST NOT exceed 15 months.",
+		Citation:      "BRs: 6.3.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &tsaCertPrivKeyUsagePeriodTooLong{},
+	})
+}
@@ -0,0 +1,59 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+BRfCSC v3.4:
+6.3.2 Certificate operational periods and key pair usage periods
+	The validity period for a Code Signing Certificate issued to a Subscriber or
+	Signing Service MUST NOT exceed 39 months.
+	The Timestamp Authority MUST use a new Timestamp Certificate with a new
+	private key no later than every 15 months to minimize the impact to users in
+	the event that a Timestamp Certificate's private key is compromised. The
+	validity for a Timestamp Certificate MUST NOT exceed 135 months.
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type tsaCertValidTimeLongerThan135Months struct{}
+
+func (l *tsaCertValidTimeLongerThan135Months) Initialize() error {
+	return nil
+}
+
+func (l *tsaCertValidTimeLongerThan135Months) CheckApplies(c *x509.Certificate) bool {
+	return util.IsTimestampCert(c)
+}
+
+func (l *tsaCertValidTimeLongerThan135Months) Execute(c *x509.Certificate) *LintResult {
+	if c.NotBefore.AddDate(0, 135, 0).Before(c.NotAfter) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_tsa_cert_valid_time_longer_than_135_months",
+		Description:   "Timestamp Certificate: the validity period MUST NOT exceed 135 months.",
+		Citation:      "BRs: 6.3.2",
+		Source:        MinimumRequirementsForCodeSigningCertificates,
+		EffectiveDate: util.MRfCSCEffectiveDate,
+		Lint:          &tsaCertValidTimeLongerThan135Months{},
+	})
+}
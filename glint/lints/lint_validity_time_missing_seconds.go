@@ -0,0 +1,71 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.5.1
+	For the purposes of this profile, UTCTime values MUST be expressed in
+	Greenwich Mean Time (Zulu) and MUST include seconds (i.e., times are
+	YYMMDDHHMMSSZ), even where the number of seconds is zero.
+
+RFC 5280: 4.1.2.5.2
+	For the purposes of this profile, GeneralizedTime values MUST be
+	expressed in Greenwich Mean Time (Zulu) and MUST include seconds (i.e.,
+	times are YYYYMMDDHHMMSSZ), even where the number of seconds is zero.
+	GeneralizedTime values MUST NOT include fractional seconds.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type validityTimeMissingSeconds struct{}
+
+func (l *validityTimeMissingSeconds) Initialize() error {
+	return nil
+}
+
+func (l *validityTimeMissingSeconds) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *validityTimeMissingSeconds) Execute(c *x509.Certificate) *LintResult {
+	notBefore, notAfter := util.GetTimes(c)
+	for _, raw := range []asn1.RawValue{notBefore, notAfter} {
+		t, err := util.ParseValidityTime(raw)
+		if err != nil {
+			return &LintResult{Status: Fatal, Details: err.Error()}
+		}
+		if !t.HasSeconds {
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s does not include seconds", t.Value)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_validity_time_missing_seconds",
+		Description:   "UTCTime and GeneralizedTime validity dates MUST include seconds.",
+		Citation:      "RFC 5280: 4.1.2.5.1",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &validityTimeMissingSeconds{},
+	})
+}
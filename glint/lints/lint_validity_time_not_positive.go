@@ -0,0 +1,58 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.5
+	The certificate validity period is the time interval during which the
+	CA warrants that it will maintain information about the status of the
+	certificate.  The field is represented as a SEQUENCE of two dates:
+	the date on which the certificate validity period begins (notBefore)
+	and the date on which the certificate validity period ends
+	(notAfter).
+******************************************************************************/
+
+import (
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type validityTimeNotPositive struct{}
+
+func (l *validityTimeNotPositive) Initialize() error {
+	return nil
+}
+
+func (l *validityTimeNotPositive) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *validityTimeNotPositive) Execute(c *x509.Certificate) *LintResult {
+	if c.NotAfter.Before(c.NotBefore) {
+		return &LintResult{Status: Error}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_validity_time_not_positive",
+		Description:   "The notAfter date of a certificate MUST NOT be before its notBefore date.",
+		Citation:      "RFC 5280: 4.1.2.5",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &validityTimeNotPositive{},
+	})
+}
@@ -0,0 +1,75 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/******************************************************************************
+RFC 5280: 4.1.2.5
+	CAs conforming to this profile MUST always encode certificate
+	validity dates through the year 2049 as UTCTime; certificate validity
+	dates in 2050 or later MUST be encoded as GeneralizedTime.
+	Conforming applications MUST be able to process validity dates that
+	are encoded in either UTCTime or GeneralizedTime.
+******************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+)
+
+type validityTimeWrongType struct{}
+
+func (l *validityTimeWrongType) Initialize() error {
+	return nil
+}
+
+func (l *validityTimeWrongType) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *validityTimeWrongType) Execute(c *x509.Certificate) *LintResult {
+	notBeforeTag, notAfterTag := util.FindTimeType(util.GetTimes(c))
+	if notBeforeTag == 0 || notAfterTag == 0 {
+		return &LintResult{Status: Fatal}
+	}
+	for _, t := range []struct {
+		name string
+		tag  int
+		time time.Time
+	}{{"notBefore", notBeforeTag, c.NotBefore}, {"notAfter", notAfterTag, c.NotAfter}} {
+		if want := util.ValidityTimeTag(t.time); t.tag != want {
+			kind := "UTCTime"
+			if want == asn1.TagGeneralizedTime {
+				kind = "GeneralizedTime"
+			}
+			return &LintResult{Status: Error, Details: fmt.Sprintf("%s of %d MUST be encoded as %s", t.name, t.time.Year(), kind)}
+		}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func init() {
+	RegisterLint(&Lint{
+		Name:          "e_validity_time_wrong_type",
+		Description:   "Validity dates through 2049 MUST be encoded as UTCTime, and dates in 2050 or later as GeneralizedTime.",
+		Citation:      "RFC 5280: 4.1.2.5",
+		Source:        RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &validityTimeWrongType{},
+	})
+}
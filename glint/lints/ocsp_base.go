@@ -0,0 +1,96 @@
+package lints
+
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+var (
+	// OCSPLints is a map of all known OCSP lints by name. Add an OCSPLint to the
+	// map by calling RegisterOCSPLint.
+	OCSPLints = make(map[string]*OCSPLint)
+)
+
+// OCSPLintInterface is implemented by each OCSPLint. It mirrors LintInterface,
+// but operates on a parsed basic OCSP response instead of a certificate.
+type OCSPLintInterface interface {
+	// Initialize runs once per-lint. It is called during RegisterOCSPLint().
+	Initialize() error
+
+	// CheckApplies runs once per OCSP response. It returns true if the
+	// OCSPLint should run on the given response. If CheckApplies returns
+	// false, the OCSPLint result is automatically set to NA without calling
+	// Execute().
+	CheckApplies(resp *ocsp.BasicOCSPResponse) bool
+
+	// Execute() is the body of the lint. It is called for every OCSP response
+	// for which CheckApplies() returns true.
+	Execute(resp *ocsp.BasicOCSPResponse) *LintResult
+}
+
+// An OCSPLint struct represents a single lint run against an OCSP response,
+// e.g. "e_ocsp_next_update_missing". The naming and severity conventions are
+// the same as for Lint.
+type OCSPLint struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Citation    string     `json:"citation,omitempty"`
+	Source      LintSource `json:"-"`
+
+	// OCSPLints automatically return NE for all OCSP responses where
+	// CheckApplies() is true but with producedAt < EffectiveDate. This check is
+	// bypassed if EffectiveDate is zero.
+	EffectiveDate time.Time `json:"-"`
+
+	// The implementation of the lint logic.
+	Lint OCSPLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if resp was produced on or after the
+// EffectiveDate. If EffectiveDate is zero, CheckEffective always returns true.
+func (l *OCSPLint) CheckEffective(resp *ocsp.BasicOCSPResponse) bool {
+	if l.EffectiveDate.IsZero() || !l.EffectiveDate.After(resp.TBSResponseData.ProducedAt) {
+		return true
+	}
+	return false
+}
+
+// Execute runs the lint against an OCSP response. The ordering is the same as
+// for Lint.Execute:
+//
+// CheckApplies()
+// CheckEffective()
+// Execute()
+func (l *OCSPLint) Execute(resp *ocsp.BasicOCSPResponse) *LintResult {
+	if !l.Lint.CheckApplies(resp) {
+		return &LintResult{Status: NA}
+	} else if !l.CheckEffective(resp) {
+		return &LintResult{Status: NE}
+	}
+	return l.Lint.Execute(resp)
+}
+
+// RegisterOCSPLint must be called once for each OCSP lint to be executed.
+// Duplicate lint names are squashed. Normally, RegisterOCSPLint is called
+// during init().
+func RegisterOCSPLint(l *OCSPLint) {
+	if err := l.Lint.Initialize(); err != nil {
+		panic("could not initialize lint: " + l.Name + ": " + err.Error())
+	}
+	OCSPLints[l.Name] = l
+}
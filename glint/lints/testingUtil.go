@@ -23,6 +23,8 @@ import (
 	"strings"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 )
 
 func ReadCertificate(inPath string) *x509.Certificate {
@@ -49,3 +51,88 @@ func ReadCertificate(inPath string) *x509.Certificate {
 	}
 	return theCert
 }
+
+func ReadCRL(inPath string) *pkix.CertificateList {
+	data, err := ioutil.ReadFile(inPath)
+	if err != nil {
+		fmt.Println(err)
+		panic("File read failed!")
+	}
+	if strings.Contains(string(data), "-BEGIN X509 CRL-") {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			panic("PEM decode failed!")
+		}
+		data = block.Bytes
+	}
+	crl, err := x509.ParseDERCRL(data)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	return crl
+}
+
+func ReadOCSPResponse(inPath string) *ocsp.BasicOCSPResponse {
+	data, err := ioutil.ReadFile(inPath)
+	if err != nil {
+		fmt.Println(err)
+		panic("File read failed!")
+	}
+	// The openssl text dump may embed the responder certificate as PEM, so
+	// skip ahead to the response block.
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "OCSP RESPONSE" {
+			data = block.Bytes
+			break
+		}
+	}
+	resp, err := ocsp.ParseResponse(data)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	return resp
+}
+
+func ReadCSR(inPath string) *x509.CertificateRequest {
+	data, err := ioutil.ReadFile(inPath)
+	if err != nil {
+		fmt.Println(err)
+		panic("File read failed!")
+	}
+	if strings.Contains(string(data), "-BEGIN CERTIFICATE REQUEST-") {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			panic("PEM decode failed!")
+		}
+		data = block.Bytes
+	}
+	csr, err := x509.ParseCertificateRequest(data)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	return csr
+}
+
+// ReadCorpus reads the certificates at inPaths as a corpus, identifying each
+// by its path.
+func ReadCorpus(inPaths ...string) []*CorpusEntry {
+	entries := make([]*CorpusEntry, len(inPaths))
+	for i, inPath := range inPaths {
+		entries[i] = &CorpusEntry{ID: inPath, Source: inPath, Cert: ReadCertificate(inPath)}
+	}
+	return entries
+}
+
+// ExecuteCorpusLint runs the named corpus lint over the certificates at
+// inPaths and returns the final result of each by path.
+func ExecuteCorpusLint(name string, inPaths ...string) map[string]*LintResult {
+	out, err := CorpusLints[name].ExecuteCorpus(ReadCorpus(inPaths...))
+	if err != nil {
+		fmt.Println(err)
+		panic("Corpus lint failed!")
+	}
+	return out
+}
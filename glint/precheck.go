@@ -0,0 +1,235 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Pre-issuance linting: lint what a CA is about to sign before it signs it.
+
+package zlint
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/lints"
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/util"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// The precheck certificate is never published, so it is signed with a key
+// generated once per process instead of the CA key. Lints only inspect the
+// TBS content, which is what the CA would sign.
+var (
+	throwawayOnce  sync.Once
+	throwawayRSA   *rsa.PrivateKey
+	throwawayECDSA map[string]*ecdsa.PrivateKey
+	throwawayErr   error
+)
+
+func throwawayKeys() error {
+	throwawayOnce.Do(func() {
+		if throwawayRSA, throwawayErr = rsa.GenerateKey(rand.Reader, 2048); throwawayErr != nil {
+			return
+		}
+		throwawayECDSA = make(map[string]*ecdsa.PrivateKey)
+		for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+			key, err := ecdsa.GenerateKey(curve, rand.Reader)
+			if err != nil {
+				throwawayErr = err
+				return
+			}
+			throwawayECDSA[curve.Params().Name] = key
+		}
+	})
+	return throwawayErr
+}
+
+// throwawaySigner returns a throwaway key able to produce signatures of type
+// alg. ECDSA keys are on the curve that matches the hash of alg. If alg is
+// unknown, the key type and curve of the issuer are used instead, and RSA is
+// used if there is no issuer.
+func throwawaySigner(alg x509.SignatureAlgorithm, issuer *x509.Certificate) (crypto.Signer, error) {
+	if err := throwawayKeys(); err != nil {
+		return nil, err
+	}
+	switch alg {
+	case x509.MD2WithRSA, x509.MD5WithRSA, x509.SHA1WithRSA, x509.SHA256WithRSA,
+		x509.SHA384WithRSA, x509.SHA512WithRSA, x509.SHA256WithRSAPSS,
+		x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS:
+		return throwawayRSA, nil
+	case x509.ECDSAWithSHA1, x509.ECDSAWithSHA256:
+		return throwawayECDSA["P-256"], nil
+	case x509.ECDSAWithSHA384:
+		return throwawayECDSA["P-384"], nil
+	case x509.ECDSAWithSHA512:
+		return throwawayECDSA["P-521"], nil
+	case x509.UnknownSignatureAlgorithm:
+		if issuer != nil && issuer.PublicKeyAlgorithm == x509.ECDSA {
+			if key, ok := throwawayECDSA[util.GetKeyCurve(issuer)]; ok {
+				return key, nil
+			}
+			return throwawayECDSA["P-256"], nil
+		}
+		return throwawayRSA, nil
+	}
+	return nil, errors.New("precheck: unsupported signature algorithm " + alg.String())
+}
+
+// signatureLints judge the signature value of a certificate. A precheck
+// certificate is signed with a throwaway key, so they are reported as NE.
+var signatureLints = map[string]bool{
+	"e_cert_signature_invalid":              true,
+	"w_ecdsa_signature_hash_curve_mismatch": true,
+}
+
+// isSelfSignedTBS returns true if the TBS content of c is that of a
+// self-signed certificate: the subject and issuer names are the same, and the
+// authority key identifier, if present, is the subject key identifier. The
+// throwaway signature cannot be verified with the subject key, so the parser
+// does not tell.
+func isSelfSignedTBS(c *x509.Certificate) bool {
+	return bytes.Equal(c.RawSubject, c.RawIssuer) &&
+		(len(c.AuthorityKeyId) == 0 || bytes.Equal(c.AuthorityKeyId, c.SubjectKeyId))
+}
+
+// lintPrecheckCertificate runs all registered certificate lints on the
+// throwaway-signed certificate c, reporting the signature lints as NE.
+func lintPrecheckCertificate(c *x509.Certificate) *ResultSet {
+	c.SelfSigned = isSelfSignedTBS(c)
+	res := new(ResultSet)
+	res.Results = make(map[string]*lints.LintResult, len(lints.Lints))
+	for name, l := range lints.Lints {
+		result := &lints.LintResult{Status: lints.NE, Details: "precheck: signature made with a throwaway key"}
+		if !signatureLints[name] {
+			result = l.Execute(c)
+		}
+		res.Results[name] = result
+		res.updateErrorStatePresent(result)
+	}
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// PrecheckCertificateRequest builds the certificate a CA would issue for csr
+// from template and issuer, signs it with a throwaway key, and runs all
+// registered certificate lints on it. Lints of the signature value are
+// reported as NE.
+//
+// The subject public key always comes from csr. The subject name comes from
+// template, or from csr when template has none. Extensions requested in csr
+// are ignored; only those in template are issued. The issuer name and
+// authority key identifier come from issuer; if issuer is nil, template.Issuer
+// is used as the issuer name.
+func PrecheckCertificateRequest(csr *x509.CertificateRequest, template *x509.Certificate, issuer *x509.Certificate) (*ResultSet, error) {
+	if csr == nil || template == nil {
+		return nil, errors.New("precheck: nil CSR or template")
+	}
+	tmpl := *template
+	if len(tmpl.RawSubject) == 0 && isEmptyName(tmpl.Subject) {
+		tmpl.RawSubject = csr.RawSubject
+	}
+	if issuer != nil && len(tmpl.AuthorityKeyId) == 0 {
+		// x509.CreateCertificate only emits the template's identifier.
+		tmpl.AuthorityKeyId = issuer.SubjectKeyId
+	}
+	parent := issuer
+	if parent == nil {
+		parent = &x509.Certificate{Subject: template.Issuer, RawSubject: template.RawIssuer}
+	}
+	key, err := throwawaySigner(tmpl.SignatureAlgorithm, issuer)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, parent, csr.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return lintPrecheckCertificate(c), nil
+}
+
+// isEmptyName returns true if name has no attributes. The zero pkix.Name
+// still encodes as a sequence holding one empty RDN.
+func isEmptyName(name pkix.Name) bool {
+	for _, rdn := range name.ToRDNSequence() {
+		if len(rdn) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// tbsSignatureAlgorithm is the prefix of a TBSCertificate up to and including
+// its signature field; the remaining fields are not needed here.
+type tbsSignatureAlgorithm struct {
+	Version            int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber       asn1.RawValue
+	SignatureAlgorithm asn1.RawValue
+}
+
+type throwawayCertificate struct {
+	TBSCertificate     asn1.RawValue
+	SignatureAlgorithm asn1.RawValue
+	SignatureValue     asn1.BitString
+}
+
+// PrecheckTBSCertificate wraps the DER encoded TBSCertificate tbs, unchanged,
+// in a certificate signed with a throwaway key, and runs all registered
+// certificate lints on it. Lints of the signature value are reported as NE.
+func PrecheckTBSCertificate(tbs []byte) (*ResultSet, error) {
+	parsed, err := x509.ParseTBSCertificate(tbs)
+	if err != nil {
+		return nil, err
+	}
+	var prefix tbsSignatureAlgorithm
+	if _, err = asn1.Unmarshal(tbs, &prefix); err != nil {
+		return nil, err
+	}
+	key, err := throwawaySigner(parsed.SignatureAlgorithm, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Only the key type has to match the signature algorithm for the
+	// certificate to parse; the signature itself is never verified.
+	digest := sha256.Sum256(tbs)
+	signature, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(throwawayCertificate{
+		TBSCertificate:     asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: prefix.SignatureAlgorithm,
+		SignatureValue:     asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
+	})
+	if err != nil {
+		return nil, err
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return lintPrecheckCertificate(c), nil
+}
@@ -0,0 +1,128 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/lints"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+func codeSigningTemplate() *x509.Certificate {
+	notBefore := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	return &x509.Certificate{
+		SerialNumber:       big.NewInt(0x1234567890abcdef),
+		Issuer:             pkix.Name{Country: []string{"US"}, Organization: []string{"Example CA"}, CommonName: "Example Code Signing CA"},
+		NotBefore:          notBefore,
+		NotAfter:           notBefore.AddDate(1, 0, 0),
+		KeyUsage:           x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}
+}
+
+func TestPrecheckCertificateRequestRSA2048(t *testing.T) {
+	inputPath := "testlint/testCSRs/csrRSA2048.pem"
+	expected := lints.Error
+	res, err := PrecheckCertificateRequest(lints.ReadCSR(inputPath), codeSigningTemplate(), nil)
+	if err != nil {
+		t.Fatalf("%s: %s", inputPath, err)
+	}
+	if out := res.Results["e_rsa_mod_less_than_3072_bits"]; out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestPrecheckCertificateRequestRSA3072(t *testing.T) {
+	inputPath := "testlint/testCSRs/csrRSA3072.pem"
+	expected := lints.Pass
+	res, err := PrecheckCertificateRequest(lints.ReadCSR(inputPath), codeSigningTemplate(), nil)
+	if err != nil {
+		t.Fatalf("%s: %s", inputPath, err)
+	}
+	if out := res.Results["e_rsa_mod_less_than_3072_bits"]; out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+	if out := res.Results["e_subject_common_name_missing"]; out.Status != expected {
+		t.Errorf("%s: subject not taken from CSR, got %s", inputPath, out.Status)
+	}
+}
+
+// checkPrecheckTBSMatches prechecks the TBSCertificate of the certificate at
+// inputPath and compares every verdict with linting the certificate itself.
+func checkPrecheckTBSMatches(t *testing.T, inputPath string) {
+	c := lints.ReadCertificate(inputPath)
+	res, err := PrecheckTBSCertificate(c.RawTBSCertificate)
+	if err != nil {
+		t.Fatalf("%s: %s", inputPath, err)
+	}
+	for name, want := range LintCertificate(c).Results {
+		got := res.Results[name]
+		if signatureLints[name] {
+			if got.Status != lints.NE {
+				t.Errorf("%s: %s: expected %s, got %s", inputPath, name, lints.NE, got.Status)
+			}
+			continue
+		}
+		if got.Status != want.Status {
+			t.Errorf("%s: %s: expected %s, got %s", inputPath, name, want.Status, got.Status)
+		}
+	}
+}
+
+func TestPrecheckTBSCertificateMatchesCertificate(t *testing.T) {
+	checkPrecheckTBSMatches(t, "testlint/testCerts/goodRsaExp.pem")
+}
+
+func TestPrecheckTBSCertificateSelfSignedRoot(t *testing.T) {
+	checkPrecheckTBSMatches(t, "testlint/testCerts/csAlgECP384RootSHA384.pem")
+}
+
+func TestPrecheckCertificateRequestIssuerKeyIdentifier(t *testing.T) {
+	inputPath := "testlint/testCSRs/csrRSA3072.pem"
+	issuerPath := "testlint/testCerts/csSigIssuer.pem"
+	cases := []struct {
+		issuer   *x509.Certificate
+		expected lints.LintStatus
+	}{
+		{lints.ReadCertificate(issuerPath), lints.Pass},
+		{nil, lints.Error},
+	}
+	for _, c := range cases {
+		res, err := PrecheckCertificateRequest(lints.ReadCSR(inputPath), codeSigningTemplate(), c.issuer)
+		if err != nil {
+			t.Fatalf("%s: %s", inputPath, err)
+		}
+		if out := res.Results["e_ext_authority_key_identifier_no_key_identifier"]; out.Status != c.expected {
+			t.Errorf("%s: issuer %t: expected %s, got %s", inputPath, c.issuer != nil, c.expected, out.Status)
+		}
+		if out := res.Results["e_ext_authority_key_identifier_critical"]; c.issuer != nil && out.Status != lints.Pass {
+			t.Errorf("%s: expected %s, got %s", inputPath, lints.Pass, out.Status)
+		}
+	}
+}
+
+func TestLintCertificateRequest(t *testing.T) {
+	inputPath := "testlint/testCSRs/csrNoCommonName.pem"
+	expected := lints.Error
+	res := LintCertificateRequest(lints.ReadCSR(inputPath))
+	if out := res.Results["e_csr_subject_common_name_missing"]; out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
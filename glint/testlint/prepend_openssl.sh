@@ -2,3 +2,13 @@
 for f in testCerts/*; do
     openssl x509 -in $f -text -noout | cat - $f > /tmp/out && mv /tmp/out $f
 done
+for f in testCRLs/*; do
+    openssl crl -in $f -text -noout | cat - $f > /tmp/out && mv /tmp/out $f
+done
+for f in testOCSP/*; do
+    sed -n '/BEGIN OCSP/,/END OCSP/p' $f | sed '1d;$d' | openssl base64 -d > /tmp/der
+    openssl ocsp -respin /tmp/der -resp_text -noverify | sed '/^-----BEGIN CERTIFICATE/,/END CERTIFICATE-----/d' | cat - $f > /tmp/out && mv /tmp/out $f
+done
+for f in testCSRs/*; do
+    openssl req -in $f -text -noout | cat - $f > /tmp/out && mv /tmp/out $f
+done
@@ -0,0 +1,157 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package truststore
+
+import (
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+var (
+	oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidCTL        = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 1}
+	oidSHA1       = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+
+	// CERT_ENHKEY_USAGE_PROP_ID: the EKUs a root is trusted for.
+	oidCTLEnhKeyUsage = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 11, 9}
+	// CERT_DISALLOWED_ENHKEY_USAGE_PROP_ID: the EKUs a root is no longer
+	// trusted for.
+	oidCTLDisallowedEnhKeyUsage = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 11, 122}
+	// CERT_NOT_BEFORE_FILETIME_PROP_ID: certificates with a later notBefore
+	// are no longer trusted through the root.
+	oidCTLNotBeforeFiletime = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 11, 126}
+	// CERT_NOT_BEFORE_ENHKEY_USAGE_PROP_ID: the EKUs the NotBefore date
+	// applies to. Without it the date applies to every EKU.
+	oidCTLNotBeforeEnhKeyUsage = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 10, 11, 127}
+)
+
+// filetimeEpoch is the Windows FILETIME epoch, 1601-01-01, in 100ns ticks
+// before the Unix epoch.
+const filetimeEpoch = 116444736000000000
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo contentInfo
+}
+
+// certificateTrustList is the Microsoft CTL carried as the content of the
+// PKCS#7 SignedData in authroot.stl.
+type certificateTrustList struct {
+	Version          int `asn1:"optional,default:0"`
+	SubjectUsage     []asn1.ObjectIdentifier
+	ListIdentifier   []byte   `asn1:"optional"`
+	SequenceNumber   *big.Int `asn1:"optional"`
+	ThisUpdate       time.Time
+	NextUpdate       time.Time `asn1:"optional"`
+	SubjectAlgorithm pkix.AlgorithmIdentifier
+	TrustedSubjects  []trustedSubject `asn1:"optional"`
+}
+
+type trustedSubject struct {
+	Identifier []byte
+	Attributes []ctlAttribute `asn1:"optional,set"`
+}
+
+type ctlAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// ParseCTL parses a Microsoft certificate trust list, such as the
+// authroot.stl extracted from authrootstl.cab, into a Store named name. Roots
+// are identified by thumbprint only, so they can only be matched against
+// certificates found in the intermediate or root pools. The signature on the
+// list is not checked.
+func ParseCTL(name string, der []byte) (*Store, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("CTL: %s", err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, errors.New("CTL: not a PKCS#7 SignedData")
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("CTL: %s", err)
+	}
+	if !sd.EncapContentInfo.ContentType.Equal(oidCTL) {
+		return nil, fmt.Errorf("CTL: unexpected content type %s", sd.EncapContentInfo.ContentType)
+	}
+	var ctl certificateTrustList
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.Content.Bytes, &ctl); err != nil {
+		return nil, fmt.Errorf("CTL: %s", err)
+	}
+
+	s := newStore(name)
+	switch {
+	case ctl.SubjectAlgorithm.Algorithm.Equal(oidSHA1):
+		s.sha1 = true
+	case ctl.SubjectAlgorithm.Algorithm.Equal(oidSHA256):
+	default:
+		return nil, fmt.Errorf("CTL: unsupported subject algorithm %s", ctl.SubjectAlgorithm.Algorithm)
+	}
+	for _, subject := range ctl.TrustedSubjects {
+		var e entry
+		for _, attr := range subject.Attributes {
+			if len(attr.Values) == 0 {
+				continue
+			}
+			var value []byte
+			if _, err := asn1.Unmarshal(attr.Values[0].FullBytes, &value); err != nil {
+				return nil, fmt.Errorf("CTL: property %s of %x: %s", attr.Type, subject.Identifier, err)
+			}
+			var err error
+			switch {
+			case attr.Type.Equal(oidCTLEnhKeyUsage):
+				_, err = asn1.Unmarshal(value, &e.usages)
+				e.restricted = true
+			case attr.Type.Equal(oidCTLDisallowedEnhKeyUsage):
+				_, err = asn1.Unmarshal(value, &e.disallowed)
+			case attr.Type.Equal(oidCTLNotBeforeEnhKeyUsage):
+				_, err = asn1.Unmarshal(value, &e.notBeforeUsages)
+			case attr.Type.Equal(oidCTLNotBeforeFiletime):
+				e.notBefore, err = parseFiletime(value)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("CTL: property %s of %x: %s", attr.Type, subject.Identifier, err)
+			}
+		}
+		s.entries[hex.EncodeToString(subject.Identifier)] = e
+	}
+	return s, nil
+}
+
+// parseFiletime decodes a little-endian Windows FILETIME.
+func parseFiletime(value []byte) (time.Time, error) {
+	if len(value) != 8 {
+		return time.Time{}, fmt.Errorf("FILETIME of %d bytes", len(value))
+	}
+	ticks := int64(binary.LittleEndian.Uint64(value)) - filetimeEpoch
+	return time.Unix(0, 0).Add(time.Duration(ticks) * 100).UTC(), nil
+}
@@ -0,0 +1,227 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package truststore loads snapshots of root program trust stores and tells
+// which of them a certificate chains to, so that requirements binding only
+// publicly-trusted CAs can be scoped to publicly-trusted hierarchies.
+package truststore
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	"github.com/zmap/zcrypto/x509"
+)
+
+var oidCodeSigning = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 3}
+
+// A Store is a snapshot of one root program's trusted roots.
+type Store struct {
+	Name    string
+	certs   *chain.Pool
+	entries map[string]entry
+	sha1    bool
+}
+
+// entry records the EKUs a root is trusted for. Roots that are not
+// restricted are trusted for every usage except the disallowed ones. A
+// non-zero notBefore stops the root from trusting certificates issued after
+// it, for the notBeforeUsages EKUs or, if there are none, for every EKU.
+type entry struct {
+	restricted      bool
+	usages          []asn1.ObjectIdentifier
+	disallowed      []asn1.ObjectIdentifier
+	notBefore       time.Time
+	notBeforeUsages []asn1.ObjectIdentifier
+}
+
+func newStore(name string) *Store {
+	return &Store{Name: name, certs: chain.NewPool(), entries: make(map[string]entry)}
+}
+
+// NewStore returns a Store named name trusting the roots in pool.
+func NewStore(name string, pool *chain.Pool) *Store {
+	s := newStore(name)
+	for _, c := range pool.Certificates() {
+		s.certs.AddCert(c)
+		s.entries[c.FingerprintSHA256.Hex()] = entry{}
+	}
+	return s
+}
+
+// LoadStore loads the store at path. A file with the .stl extension is
+// parsed as a Microsoft CTL; anything else is loaded as a directory or bundle
+// of root certificates.
+func LoadStore(name string, path string) (*Store, error) {
+	if strings.EqualFold(filepath.Ext(path), ".stl") {
+		der, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return ParseCTL(name, der)
+	}
+	pool := chain.NewPool()
+	if _, err := pool.AppendFromPath(path); err != nil {
+		return nil, err
+	}
+	return NewStore(name, pool), nil
+}
+
+// Len returns the number of roots in the store.
+func (s *Store) Len() int {
+	return len(s.entries)
+}
+
+// Certificates returns the root certificates held by the store. Stores loaded
+// from a CTL only hold thumbprints and return no certificates.
+func (s *Store) Certificates() []*x509.Certificate {
+	return s.certs.Certificates()
+}
+
+// Trusts returns true if c is a root of the store trusted for code signing.
+func (s *Store) Trusts(c *x509.Certificate) bool {
+	e, ok := s.lookup(c)
+	return ok && e.trustsCodeSigning()
+}
+
+// TrustsPath returns true if p contains a root of the store trusted for code
+// signing certificates issued at the notBefore of the first certificate of p.
+func (s *Store) TrustsPath(p *chain.Path) bool {
+	if len(p.Certificates) == 0 {
+		return false
+	}
+	issued := p.Certificates[0].NotBefore
+	for _, c := range p.Certificates {
+		e, ok := s.lookup(c)
+		if !ok || !e.trustsCodeSigning() {
+			continue
+		}
+		if e.notBefore.IsZero() || !issued.After(e.notBefore) {
+			return true
+		}
+		if len(e.notBeforeUsages) > 0 && !hasUsage(e.notBeforeUsages, oidCodeSigning) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) lookup(c *x509.Certificate) (entry, bool) {
+	fp := c.FingerprintSHA256.Hex()
+	if s.sha1 {
+		fp = c.FingerprintSHA1.Hex()
+	}
+	e, ok := s.entries[fp]
+	return e, ok
+}
+
+func (e entry) trustsCodeSigning() bool {
+	if hasUsage(e.disallowed, oidCodeSigning) {
+		return false
+	}
+	return !e.restricted || hasUsage(e.usages, oidCodeSigning)
+}
+
+func hasUsage(usages []asn1.ObjectIdentifier, usage asn1.ObjectIdentifier) bool {
+	for _, u := range usages {
+		if u.Equal(usage) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	defaultStores []*Store
+	scoped        bool
+)
+
+// Default returns the stores set by SetDefault.
+func Default() []*Store {
+	return defaultStores
+}
+
+// SetDefault sets the stores certificates are tagged against. If scope is
+// true, InScope only accepts certificates that chain to one of them.
+func SetDefault(stores []*Store, scope bool) {
+	defaultStores = stores
+	scoped = scope
+	scopeCache.Lock()
+	scopeCache.raw = nil
+	scopeCache.Unlock()
+}
+
+// TrustedBy returns the names of the default stores that c chains to. A
+// certificate chains to a store when every link of one of its paths,
+// built by chain.Default(), verifies and the path contains a root the store
+// trusts for code signing certificates issued at c's notBefore.
+func TrustedBy(c *x509.Certificate) []string {
+	if len(defaultStores) == 0 {
+		return nil
+	}
+	paths := chain.Default().Build(c)
+	if len(paths) == 0 {
+		paths = []*chain.Path{{Certificates: []*x509.Certificate{c}}}
+	}
+	var names []string
+	for _, s := range defaultStores {
+		if trustedByStore(s, paths) {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+func trustedByStore(s *Store, paths []*chain.Path) bool {
+	for _, p := range paths {
+		if p.LinksVerified() && s.TrustsPath(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPubliclyTrusted returns true if c chains to any of the default stores.
+func IsPubliclyTrusted(c *x509.Certificate) bool {
+	return len(TrustedBy(c)) > 0
+}
+
+// scopeCache holds the answer for the last certificate, since InScope is
+// called by every lint run on it.
+var scopeCache struct {
+	sync.Mutex
+	raw []byte
+	in  bool
+}
+
+// InScope returns true if requirements binding publicly-trusted CAs apply to
+// c: always when scoping is off, otherwise when c chains to a default store.
+func InScope(c *x509.Certificate) bool {
+	if !scoped {
+		return true
+	}
+	scopeCache.Lock()
+	defer scopeCache.Unlock()
+	if scopeCache.raw == nil || !bytes.Equal(scopeCache.raw, c.Raw) {
+		scopeCache.raw = c.Raw
+		scopeCache.in = IsPubliclyTrusted(c)
+	}
+	return scopeCache.in
+}
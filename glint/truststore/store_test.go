@@ -0,0 +1,253 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package truststore
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/chain"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+var oidServerAuth = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 1}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issue signs a certificate for name with a new key. A nil issuer
+// self-signs.
+func issue(t *testing.T, name string, issuer *testCA, isCA bool) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		SubjectKeyId:          []byte(name),
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	}
+	parent, signer := tmpl, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+		tmpl.AuthorityKeyId = issuer.cert.SubjectKeyId
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: c, key: key}
+}
+
+// buildCTL returns an unsigned authroot.stl-style CTL trusting the given
+// SHA-1 thumbprints, each with the properties in attrs.
+func buildCTL(t *testing.T, thumbprints [][]byte, attrs [][]ctlAttribute) []byte {
+	ctl := certificateTrustList{
+		SubjectUsage:     []asn1.ObjectIdentifier{{1, 3, 6, 1, 4, 1, 311, 20, 1}},
+		ThisUpdate:       time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		SubjectAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1},
+	}
+	for i, tp := range thumbprints {
+		ctl.TrustedSubjects = append(ctl.TrustedSubjects, trustedSubject{Identifier: tp, Attributes: attrs[i]})
+	}
+	ctlDER, err := asn1.Marshal(ctl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdDER, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true},
+		EncapContentInfo: contentInfo{ContentType: oidCTL, Content: explicit0(ctlDER)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: explicit0(sdDER)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+// property wraps value in a CTL property attribute of type typ.
+func property(t *testing.T, typ asn1.ObjectIdentifier, value []byte) ctlAttribute {
+	der, err := asn1.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctlAttribute{Type: typ, Values: []asn1.RawValue{{FullBytes: der}}}
+}
+
+func ekuProperty(t *testing.T, typ asn1.ObjectIdentifier, usages ...asn1.ObjectIdentifier) ctlAttribute {
+	seq, err := asn1.Marshal(usages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return property(t, typ, seq)
+}
+
+func filetimeProperty(t *testing.T, typ asn1.ObjectIdentifier, when time.Time) ctlAttribute {
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint64(value, uint64(when.UnixNano()/100+filetimeEpoch))
+	return property(t, typ, value)
+}
+
+// explicit0 wraps der in an explicit [0] tag. Marshal writes RawValue fields
+// as they are, without the explicit tag from the struct.
+func explicit0(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func TestParseCTL(t *testing.T) {
+	open := issue(t, "Unrestricted Root", nil, true)
+	tls := issue(t, "TLS Root", nil, true)
+	cs := issue(t, "Code Signing Root", nil, true)
+	disallowed := issue(t, "Disallowed Root", nil, true)
+	other := issue(t, "Other Root", nil, true)
+
+	der := buildCTL(t,
+		[][]byte{open.cert.FingerprintSHA1, tls.cert.FingerprintSHA1, cs.cert.FingerprintSHA1, disallowed.cert.FingerprintSHA1},
+		[][]ctlAttribute{
+			nil,
+			{ekuProperty(t, oidCTLEnhKeyUsage, oidServerAuth)},
+			{ekuProperty(t, oidCTLEnhKeyUsage, oidServerAuth, oidCodeSigning)},
+			{ekuProperty(t, oidCTLDisallowedEnhKeyUsage, oidCodeSigning)},
+		})
+	store, err := ParseCTL("microsoft", der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Len() != 4 {
+		t.Errorf("expected 4 roots, got %d", store.Len())
+	}
+	for _, tc := range []struct {
+		root    *testCA
+		trusted bool
+	}{
+		{open, true},
+		{tls, false},
+		{cs, true},
+		{disallowed, false},
+		{other, false},
+	} {
+		if got := store.Trusts(tc.root.cert); got != tc.trusted {
+			t.Errorf("%s: expected trusted %v, got %v", tc.root.cert.Subject.CommonName, tc.trusted, got)
+		}
+	}
+}
+
+func TestTrustedBy(t *testing.T) {
+	root := issue(t, "Public Root", nil, true)
+	inter := issue(t, "Public Intermediate", root, true)
+	leaf := issue(t, "Public Leaf", inter, false)
+	privateRoot := issue(t, "Private Root", nil, true)
+	privateLeaf := issue(t, "Private Leaf", privateRoot, false)
+
+	rootPool := chain.NewPool()
+	rootPool.AddCert(root.cert)
+	pemStore := NewStore("mozilla", rootPool)
+	ctlStore, err := ParseCTL("microsoft", buildCTL(t, [][]byte{root.cert.FingerprintSHA1}, [][]ctlAttribute{nil}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	intermediates := chain.NewPool()
+	intermediates.AddCert(inter.cert)
+	intermediates.AddCert(privateRoot.cert)
+	chain.SetDefault(chain.NewBuilder(intermediates, rootPool))
+	SetDefault([]*Store{pemStore, ctlStore}, true)
+	defer func() {
+		chain.SetDefault(chain.NewBuilder(nil, nil))
+		SetDefault(nil, false)
+	}()
+
+	if names := TrustedBy(leaf.cert); len(names) != 2 {
+		t.Errorf("expected the leaf to chain to both stores, got %v", names)
+	}
+	if names := TrustedBy(privateLeaf.cert); len(names) != 0 {
+		t.Errorf("expected the private leaf to chain to no store, got %v", names)
+	}
+	if !InScope(leaf.cert) || InScope(privateLeaf.cert) {
+		t.Error("expected only the publicly-trusted leaf to be in scope")
+	}
+	SetDefault([]*Store{pemStore, ctlStore}, false)
+	if !InScope(privateLeaf.cert) {
+		t.Error("expected every certificate to be in scope when scoping is off")
+	}
+}
+
+func TestTrustedByNotBefore(t *testing.T) {
+	root := issue(t, "Public Root", nil, true)
+	leaf := issue(t, "Public Leaf", root, false)
+	issued := leaf.cert.NotBefore
+
+	rootPool := chain.NewPool()
+	rootPool.AddCert(root.cert)
+	chain.SetDefault(chain.NewBuilder(nil, rootPool))
+	defer func() {
+		chain.SetDefault(chain.NewBuilder(nil, nil))
+		SetDefault(nil, false)
+	}()
+
+	for _, tc := range []struct {
+		name    string
+		attrs   []ctlAttribute
+		trusted bool
+	}{
+		{"NotBefore after issuance", []ctlAttribute{filetimeProperty(t, oidCTLNotBeforeFiletime, issued.AddDate(0, 1, 0))}, true},
+		{"NotBefore before issuance", []ctlAttribute{filetimeProperty(t, oidCTLNotBeforeFiletime, issued.AddDate(0, -1, 0))}, false},
+		{"NotBefore for code signing", []ctlAttribute{
+			filetimeProperty(t, oidCTLNotBeforeFiletime, issued.AddDate(0, -1, 0)),
+			ekuProperty(t, oidCTLNotBeforeEnhKeyUsage, oidCodeSigning),
+		}, false},
+		{"NotBefore for other EKUs", []ctlAttribute{
+			filetimeProperty(t, oidCTLNotBeforeFiletime, issued.AddDate(0, -1, 0)),
+			ekuProperty(t, oidCTLNotBeforeEnhKeyUsage, oidServerAuth),
+		}, true},
+		{"code signing disallowed", []ctlAttribute{ekuProperty(t, oidCTLDisallowedEnhKeyUsage, oidCodeSigning)}, false},
+	} {
+		store, err := ParseCTL("microsoft", buildCTL(t, [][]byte{root.cert.FingerprintSHA1}, [][]ctlAttribute{tc.attrs}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetDefault([]*Store{store}, true)
+		if got := len(TrustedBy(leaf.cert)) == 1; got != tc.trusted {
+			t.Errorf("%s: expected trusted %v, got %v", tc.name, tc.trusted, got)
+		}
+		if got := InScope(leaf.cert); got != tc.trusted {
+			t.Errorf("%s: expected in scope %v, got %v", tc.name, tc.trusted, got)
+		}
+	}
+}
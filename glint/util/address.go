@@ -0,0 +1,159 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A Subdivision is an ISO 3166-2 country subdivision, such as a state or
+// province.
+type Subdivision struct {
+	// Code is the full ISO 3166-2 code, e.g. "US-CA".
+	Code string
+	// Names holds the ISO 3166-2 name followed by the other names the
+	// subdivision is commonly written as, such as the name without
+	// diacritics or in English.
+	Names []string
+}
+
+//go:embed data/iso3166_2.tsv
+var iso3166_2 []byte
+
+//go:embed data/postal_codes.tsv
+var postalCodes []byte
+
+// subdivisions maps an ISO 3166-1 country code to its subdivisions.
+var subdivisions = mustLoadSubdivisions(iso3166_2)
+
+// postalCodeFormats maps an ISO 3166-1 country code to the format of its
+// postal codes, or to nil if it has no postal code system.
+var postalCodeFormats = mustLoadPostalCodeFormats(postalCodes)
+
+// tsvLines calls fn with the tab separated fields of each line of data,
+// skipping blank lines and lines starting with #.
+func tsvLines(data []byte, fn func(n int, fields []string) error) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := fn(n, strings.Split(line, "\t")); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func mustLoadSubdivisions(data []byte) map[string][]Subdivision {
+	out := make(map[string][]Subdivision)
+	err := tsvLines(data, func(n int, fields []string) error {
+		if len(fields) < 2 || len(fields[0]) < 4 || fields[0][2] != '-' {
+			return fmt.Errorf("line %d: expected code<TAB>name", n)
+		}
+		country := fields[0][:2]
+		out[country] = append(out[country], Subdivision{Code: fields[0], Names: fields[1:]})
+		return nil
+	})
+	if err != nil {
+		panic("invalid ISO 3166-2 subdivision list: " + err.Error())
+	}
+	return out
+}
+
+func mustLoadPostalCodeFormats(data []byte) map[string]*regexp.Regexp {
+	out := make(map[string]*regexp.Regexp)
+	err := tsvLines(data, func(n int, fields []string) error {
+		if len(fields) > 2 || len(fields[0]) != 2 {
+			return fmt.Errorf("line %d: expected country<TAB>pattern", n)
+		}
+		if len(fields) == 1 || fields[1] == "" {
+			out[fields[0]] = nil
+			return nil
+		}
+		format, err := regexp.Compile(`^(?:` + fields[1] + `)$`)
+		if err != nil {
+			return fmt.Errorf("line %d: %s", n, err)
+		}
+		out[fields[0]] = format
+		return nil
+	})
+	if err != nil {
+		panic("invalid postal code format list: " + err.Error())
+	}
+	return out
+}
+
+// GetSubdivisions returns the ISO 3166-2 subdivisions of country, or nil if
+// it has none or is not an ISO 3166-1 country code.
+func GetSubdivisions(country string) []Subdivision {
+	return subdivisions[strings.ToUpper(strings.TrimSpace(country))]
+}
+
+// LookupSubdivision returns the subdivision of country that name is the
+// name or ISO 3166-2 code of, with or without the country prefix. Names are
+// compared without regard to case.
+func LookupSubdivision(country, name string) (Subdivision, bool) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	name = strings.TrimSpace(name)
+	for _, s := range subdivisions[country] {
+		if strings.EqualFold(name, s.Code) || strings.EqualFold(name, s.Code[3:]) {
+			return s, true
+		}
+		for _, n := range s.Names {
+			if strings.EqualFold(name, n) {
+				return s, true
+			}
+		}
+	}
+	return Subdivision{}, false
+}
+
+// IsSubdivisionOf returns true if name is the name or ISO 3166-2 code of a
+// subdivision of country, as accepted by LookupSubdivision.
+func IsSubdivisionOf(country, name string) bool {
+	_, ok := LookupSubdivision(country, name)
+	return ok
+}
+
+// GetPostalCodeFormat returns the format of the postal codes of country and
+// whether it is known. The format is nil for a country that has no postal
+// code system.
+func GetPostalCodeFormat(country string) (*regexp.Regexp, bool) {
+	format, ok := postalCodeFormats[strings.ToUpper(strings.TrimSpace(country))]
+	return format, ok
+}
+
+// IsSubdivisionName returns true if name is one of the names of a
+// subdivision of country, compared without regard to case. Unlike
+// IsSubdivisionOf, it does not accept subdivision codes.
+func IsSubdivisionName(country, name string) bool {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	name = strings.TrimSpace(name)
+	for _, s := range subdivisions[country] {
+		for _, n := range s.Names {
+			if strings.EqualFold(name, n) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+/*
+ * ZLint Copyright 2018 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import "testing"
+
+func TestIsSubdivisionOf(t *testing.T) {
+	cases := []struct {
+		country, name string
+		want          bool
+	}{
+		{"US", "California", true},
+		{"us", "california", true},
+		{"US", "CA", true},
+		{"US", "US-CA", true},
+		{"US", "Ontario", false},
+		{"CA", "Ontario", true},
+		{"DE", "Bayern", true},
+		{"DE", "Bavaria", true},
+		{"IN", "Karnataka", true},
+		{"CN", "Beijing", true},
+		{"GB", "London", true},
+		{"XX", "California", false},
+	}
+	for _, c := range cases {
+		if got := IsSubdivisionOf(c.country, c.name); got != c.want {
+			t.Errorf("IsSubdivisionOf(%q, %q) = %t, want %t", c.country, c.name, got, c.want)
+		}
+	}
+}
+
+func TestGetPostalCodeFormat(t *testing.T) {
+	cases := []struct {
+		country, code string
+		known, valid  bool
+	}{
+		{"US", "94043", true, true},
+		{"US", "94043-1351", true, true},
+		{"US", "9404", true, false},
+		{"CA", "K1A 0B1", true, true},
+		{"GB", "SW1A 1AA", true, true},
+		{"NL", "1012 JS", true, true},
+		{"DE", "1012 JS", true, false},
+		{"HK", "", true, false},
+		{"XX", "12345", false, false},
+	}
+	for _, c := range cases {
+		format, known := GetPostalCodeFormat(c.country)
+		if known != c.known {
+			t.Errorf("GetPostalCodeFormat(%q) known = %t, want %t", c.country, known, c.known)
+			continue
+		}
+		if valid := format != nil && format.MatchString(c.code); valid != c.valid {
+			t.Errorf("postal code %q in %s valid = %t, want %t", c.code, c.country, valid, c.valid)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zmap/zcrypto/dsa"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// An AlgorithmRole is a set of the kinds of certificate an AlgorithmPolicy
+// applies to.
+type AlgorithmRole int
+
+const (
+	RoleSubscriber AlgorithmRole = 1 << iota
+	RoleTimestamp
+	RoleSubCA
+	RoleRootCA
+	// RoleOCSP is that of the OCSP responses of a CA.
+	RoleOCSP
+
+	RoleCA  = RoleSubCA | RoleRootCA
+	RoleAny = RoleSubscriber | RoleTimestamp | RoleCA | RoleOCSP
+)
+
+func (r AlgorithmRole) String() string {
+	switch r {
+	case RoleSubscriber:
+		return "Subscriber"
+	case RoleTimestamp:
+		return "Timestamp Authority"
+	case RoleSubCA:
+		return "Subordinate CA"
+	case RoleRootCA:
+		return "Root CA"
+	case RoleOCSP:
+		return "OCSP response"
+	}
+	return fmt.Sprintf("AlgorithmRole(%d)", int(r))
+}
+
+// GetAlgorithmRole returns the role c is judged under by the algorithm
+// policy timeline.
+func GetAlgorithmRole(c *x509.Certificate) AlgorithmRole {
+	switch {
+	case IsRootCA(c):
+		return RoleRootCA
+	case IsCACert(c):
+		return RoleSubCA
+	case IsTimestampCert(c):
+		return RoleTimestamp
+	}
+	return RoleSubscriber
+}
+
+// An AlgorithmPolicy is one entry of the algorithm policy timeline. It
+// permits a signature algorithm or a subject public key algorithm in the
+// certificates of Roles whose notBefore is in [From, Until). A zero From or
+// Until leaves that end of the period open.
+type AlgorithmPolicy struct {
+	// Signature is the permitted signatureAlgorithm, or
+	// UnknownSignatureAlgorithm for a subject public key entry.
+	Signature x509.SignatureAlgorithm
+	// Key is the permitted subject public key algorithm of an entry with no
+	// Signature.
+	Key x509.PublicKeyAlgorithm
+	// MinBits is the minimum RSA modulus or DSA L length of a key entry.
+	MinBits int
+	// Curves are the permitted curves of an ECDSA key entry or, for an ECDSA
+	// signature entry, the curves of the issuer key it may be made with.
+	Curves []string
+	Roles  AlgorithmRole
+	From   time.Time
+	Until  time.Time
+	// Discouraged marks algorithms that are permitted but not broadly
+	// supported by relying party software.
+	Discouraged bool
+}
+
+func (p AlgorithmPolicy) covers(role AlgorithmRole, notBefore time.Time) bool {
+	return p.Roles&role != 0 &&
+		(p.From.IsZero() || !notBefore.Before(p.From)) &&
+		(p.Until.IsZero() || notBefore.Before(p.Until))
+}
+
+var (
+	curveP256  = []string{"P-256"}
+	curveP384  = []string{"P-384"}
+	curveP521  = []string{"P-521"}
+	nistCurves = []string{"P-256", "P-384", "P-521"}
+)
+
+// algorithmPolicies is the algorithm policy timeline of the MRfCSC and the
+// BRfCSC. An algorithm that no entry covers for a role and date, such as
+// MD2 or MD5, is not permitted.
+var algorithmPolicies = []AlgorithmPolicy{
+	// BRfCSC 7.1.3.2: signature algorithms.
+	{Signature: x509.SHA256WithRSA, Roles: RoleAny},
+	{Signature: x509.SHA384WithRSA, Roles: RoleAny},
+	{Signature: x509.SHA512WithRSA, Roles: RoleAny},
+	{Signature: x509.SHA256WithRSAPSS, Roles: RoleAny, Discouraged: true},
+	{Signature: x509.SHA384WithRSAPSS, Roles: RoleAny, Discouraged: true},
+	{Signature: x509.SHA512WithRSAPSS, Roles: RoleAny, Discouraged: true},
+	{Signature: x509.ECDSAWithSHA256, Curves: curveP256, Roles: RoleAny},
+	{Signature: x509.ECDSAWithSHA384, Curves: curveP384, Roles: RoleAny},
+	{Signature: x509.ECDSAWithSHA512, Curves: curveP521, Roles: RoleAny},
+	{Signature: x509.DSAWithSHA256, Roles: RoleAny},
+	// SHA-1 ended with the SHA-1 sunset, except in OCSP responses and in
+	// Timestamp Authority certificates with a notBefore no later than
+	// 2022-04-30.
+	{Signature: x509.SHA1WithRSA, Roles: RoleSubscriber | RoleCA, Until: NO_SHA1},
+	{Signature: x509.DSAWithSHA1, Roles: RoleSubscriber | RoleCA, Until: NO_SHA1},
+	{Signature: x509.ECDSAWithSHA1, Roles: RoleSubscriber | RoleCA, Until: NO_SHA1},
+	{Signature: x509.SHA1WithRSA, Roles: RoleTimestamp, Until: BRfCSCV30SHA1TimestampDate},
+	{Signature: x509.DSAWithSHA1, Roles: RoleTimestamp, Until: BRfCSCV30SHA1TimestampDate},
+	{Signature: x509.SHA1WithRSA, Roles: RoleOCSP},
+	{Signature: x509.DSAWithSHA1, Roles: RoleOCSP},
+
+	// BRfCSC 6.1.5.1 and 6.1.5.2: subject public keys.
+	{Key: x509.RSA, MinBits: 1024, Roles: RoleSubscriber | RoleTimestamp | RoleSubCA, Until: NoRSA1024Date},
+	{Key: x509.RSA, MinBits: 1024, Roles: RoleRootCA, Until: NoRSA1024RootDate},
+	{Key: x509.RSA, MinBits: 2048, Roles: RoleSubscriber | RoleTimestamp | RoleSubCA, From: NoRSA1024Date, Until: BRfCSCV21KeySizeTransitionDate},
+	{Key: x509.RSA, MinBits: 2048, Roles: RoleRootCA, From: NoRSA1024RootDate, Until: BRfCSCV21KeySizeTransitionDate},
+	{Key: x509.RSA, MinBits: 3072, Roles: RoleSubscriber | RoleTimestamp, From: BRfCSCV21KeySizeTransitionDate},
+	{Key: x509.RSA, MinBits: 4096, Roles: RoleCA, From: BRfCSCV21KeySizeTransitionDate},
+	{Key: x509.DSA, MinBits: 1024, Roles: RoleAny, Until: NoRSA1024Date},
+	{Key: x509.DSA, MinBits: 2048, Roles: RoleAny, From: NoRSA1024Date, Until: BRfCSCV21KeySizeTransitionDate},
+	// Since the key size transition, CA keys are RSA or ECDSA only.
+	{Key: x509.DSA, MinBits: 2048, Roles: RoleSubscriber | RoleTimestamp, From: BRfCSCV21KeySizeTransitionDate},
+	{Key: x509.ECDSA, Curves: nistCurves, Roles: RoleAny},
+}
+
+// GetSignaturePolicy returns the timeline entry that permits alg in the
+// certificates or OCSP responses of role issued at notBefore, if there is
+// one.
+func GetSignaturePolicy(alg x509.SignatureAlgorithm, role AlgorithmRole, notBefore time.Time) (AlgorithmPolicy, bool) {
+	for _, p := range algorithmPolicies {
+		if p.Signature != x509.UnknownSignatureAlgorithm && p.Signature == alg && p.covers(role, notBefore) {
+			return p, true
+		}
+	}
+	return AlgorithmPolicy{}, false
+}
+
+// GetKeyPolicy returns the timeline entry that permits keys of alg in the
+// certificates of role issued at notBefore, if there is one.
+func GetKeyPolicy(alg x509.PublicKeyAlgorithm, role AlgorithmRole, notBefore time.Time) (AlgorithmPolicy, bool) {
+	for _, p := range algorithmPolicies {
+		if p.Signature == x509.UnknownSignatureAlgorithm && p.Key == alg && p.covers(role, notBefore) {
+			return p, true
+		}
+	}
+	return AlgorithmPolicy{}, false
+}
+
+// GetMinKeyBits returns the minimum RSA modulus or DSA L length of the
+// certificates of role issued at notBefore, or 0 if keys of alg are not
+// permitted in them.
+func GetMinKeyBits(alg x509.PublicKeyAlgorithm, role AlgorithmRole, notBefore time.Time) int {
+	p, ok := GetKeyPolicy(alg, role, notBefore)
+	if !ok {
+		return 0
+	}
+	return p.MinBits
+}
+
+// CheckSignaturePolicy returns the timeline entry that permits the
+// signatureAlgorithm of c, or an error describing why it is not permitted.
+func CheckSignaturePolicy(c *x509.Certificate) (AlgorithmPolicy, error) {
+	role := GetAlgorithmRole(c)
+	p, ok := GetSignaturePolicy(c.SignatureAlgorithm, role, c.NotBefore)
+	if !ok {
+		return p, fmt.Errorf("%s is not permitted in %s certificates issued on %s", c.SignatureAlgorithm, role, c.NotBefore.Format("2006-01-02"))
+	}
+	return p, nil
+}
+
+// CheckKeyPolicy returns an error describing why the subject public key of
+// c is not permitted by the timeline, or nil if it is.
+func CheckKeyPolicy(c *x509.Certificate) error {
+	role := GetAlgorithmRole(c)
+	p, ok := GetKeyPolicy(c.PublicKeyAlgorithm, role, c.NotBefore)
+	if !ok {
+		return fmt.Errorf("%s keys are not permitted in %s certificates issued on %s", c.PublicKeyAlgorithm, role, c.NotBefore.Format("2006-01-02"))
+	}
+	switch key := c.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if bits := key.N.BitLen(); bits < p.MinBits {
+			return fmt.Errorf("RSA modulus is %d bits, %s certificates issued on %s require at least %d", bits, role, c.NotBefore.Format("2006-01-02"), p.MinBits)
+		}
+	case *dsa.PublicKey:
+		l, n := key.P.BitLen(), key.Q.BitLen()
+		if l < p.MinBits {
+			return fmt.Errorf("DSA key length (L) is %d bits, %s certificates issued on %s require at least %d", l, role, c.NotBefore.Format("2006-01-02"), p.MinBits)
+		}
+		if p.MinBits >= 2048 && n != 224 && n != 256 {
+			return fmt.Errorf("DSA modulus length (N) is %d bits, not 224 or 256", n)
+		}
+	default:
+		if curve := GetKeyCurve(c); curve != "" && !containsString(p.Curves, curve) {
+			return fmt.Errorf("ECDSA curve %s is not permitted in %s certificates", curve, role)
+		}
+	}
+	return nil
+}
+
+// GetKeyCurve returns the name of the curve of the ECDSA subject public key
+// of c, or "" if it has none.
+func GetKeyCurve(c *x509.Certificate) string {
+	var key *ecdsa.PublicKey
+	switch k := c.PublicKey.(type) {
+	case *x509.AugmentedECDSA:
+		key = k.Pub
+	case *ecdsa.PublicKey:
+		key = k
+	}
+	if key == nil || key.Curve == nil {
+		return ""
+	}
+	return key.Curve.Params().Name
+}
+
+// GetSignatureCurve returns the name of the curve of the key that made the
+// ECDSA signature of c, or "" if it cannot be told. The curve of a
+// self-signed certificate is that of its own key; otherwise it is inferred
+// from the size of the signature values, which are less than the order of
+// the curve.
+func GetSignatureCurve(c *x509.Certificate) string {
+	switch c.SignatureAlgorithm {
+	case x509.ECDSAWithSHA1, x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512:
+	default:
+		return ""
+	}
+	if IsSelfSigned(c) {
+		return GetKeyCurve(c)
+	}
+	var sig struct{ R, S *big.Int }
+	if rest, err := asn1.Unmarshal(c.Signature, &sig); err != nil || len(rest) > 0 {
+		return ""
+	}
+	bits := sig.R.BitLen()
+	if sig.S.BitLen() > bits {
+		bits = sig.S.BitLen()
+	}
+	switch {
+	case bits > 384:
+		return "P-521"
+	case bits > 256:
+		return "P-384"
+	case bits > 224:
+		return "P-256"
+	}
+	return ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
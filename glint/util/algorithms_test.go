@@ -0,0 +1,93 @@
+/*
+ * ZLint Copyright 2018 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func TestGetMinKeyBits(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	cases := []struct {
+		alg       x509.PublicKeyAlgorithm
+		role      AlgorithmRole
+		notBefore time.Time
+		want      int
+	}{
+		{x509.RSA, RoleSubscriber, day(2013, time.December, 31), 1024},
+		{x509.RSA, RoleSubscriber, day(2014, time.January, 1), 2048},
+		{x509.RSA, RoleSubscriber, day(2021, time.June, 1), 3072},
+		{x509.RSA, RoleTimestamp, day(2021, time.June, 1), 3072},
+		{x509.RSA, RoleRootCA, day(2012, time.January, 1), 2048},
+		{x509.RSA, RoleSubCA, day(2012, time.January, 1), 1024},
+		{x509.RSA, RoleSubCA, day(2021, time.June, 1), 4096},
+		{x509.RSA, RoleRootCA, day(2021, time.June, 1), 4096},
+		{x509.DSA, RoleSubscriber, day(2022, time.January, 1), 2048},
+		{x509.DSA, RoleSubCA, day(2020, time.January, 1), 2048},
+		{x509.DSA, RoleSubCA, day(2022, time.January, 1), 0},
+	}
+	for _, c := range cases {
+		if got := GetMinKeyBits(c.alg, c.role, c.notBefore); got != c.want {
+			t.Errorf("GetMinKeyBits(%s, %s, %s) = %d, want %d", c.alg, c.role, c.notBefore.Format("2006-01-02"), got, c.want)
+		}
+	}
+}
+
+func TestGetSignaturePolicy(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	cases := []struct {
+		alg       x509.SignatureAlgorithm
+		role      AlgorithmRole
+		notBefore time.Time
+		want      bool
+	}{
+		{x509.SHA256WithRSA, RoleSubscriber, day(2023, time.January, 1), true},
+		{x509.SHA1WithRSA, RoleSubscriber, day(2015, time.December, 31), true},
+		{x509.SHA1WithRSA, RoleSubscriber, day(2016, time.January, 1), false},
+		{x509.SHA1WithRSA, RoleSubCA, day(2016, time.January, 1), false},
+		{x509.SHA1WithRSA, RoleTimestamp, day(2022, time.April, 30), true},
+		{x509.DSAWithSHA1, RoleTimestamp, day(2022, time.May, 1), false},
+		{x509.SHA1WithRSA, RoleOCSP, day(2023, time.January, 1), true},
+		{x509.ECDSAWithSHA1, RoleTimestamp, day(2015, time.January, 1), false},
+		{x509.MD5WithRSA, RoleSubscriber, day(2010, time.January, 1), false},
+		{x509.MD2WithRSA, RoleRootCA, day(2000, time.January, 1), false},
+	}
+	for _, c := range cases {
+		if _, got := GetSignaturePolicy(c.alg, c.role, c.notBefore); got != c.want {
+			t.Errorf("GetSignaturePolicy(%s, %s, %s) = %t, want %t", c.alg, c.role, c.notBefore.Format("2006-01-02"), got, c.want)
+		}
+	}
+}
+
+func TestGetAlgorithmRole(t *testing.T) {
+	cases := []struct {
+		name string
+		cert *x509.Certificate
+		want AlgorithmRole
+	}{
+		{"timestamping", &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping}}, RoleTimestamp},
+		{"code signing", &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}}, RoleSubscriber},
+		{"code signing and timestamping", &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping, x509.ExtKeyUsageCodeSigning}}, RoleSubscriber},
+		{"sub CA", &x509.Certificate{IsCA: true, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping}}, RoleSubCA},
+	}
+	for _, c := range cases {
+		if got := GetAlgorithmRole(c.cert); got != c.want {
+			t.Errorf("GetAlgorithmRole(%s) = %s, want %s", c.name, got, c.want)
+		}
+	}
+}
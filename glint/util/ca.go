@@ -57,6 +57,22 @@ func IsSubscriberCert(c *x509.Certificate) bool {
 	return !IsCACert(c) && !IsSelfSigned(c)
 }
 
+// IsTimestampCert returns true if c is a subscriber certificate issued to a
+// Timestamp Authority, i.e. it asserts id-kp-timeStamping and not
+// id-kp-codeSigning. A certificate asserting both is a code signing
+// certificate with an extra EKU.
+func IsTimestampCert(c *x509.Certificate) bool {
+	if !IsSubscriberCert(c) || IsCodeSigningCert(c) {
+		return false
+	}
+	for _, eku := range c.ExtKeyUsage {
+		if eku == x509.ExtKeyUsageTimeStamping {
+			return true
+		}
+	}
+	return false
+}
+
 func IsCodeSigningCert(cert *x509.Certificate) bool {
 	for _, eku := range cert.ExtKeyUsage {
 		if eku == x509.ExtKeyUsageCodeSigning {
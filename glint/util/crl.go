@@ -0,0 +1,105 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// contains helper functions for CRL lints
+
+package util
+
+import (
+	"encoding/asn1"
+	"errors"
+	"time"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// CRLReason values from RFC 5280: 5.3.1. The value 7 is not used.
+const (
+	ReasonUnspecified          = 0
+	ReasonKeyCompromise        = 1
+	ReasonCACompromise         = 2
+	ReasonAffiliationChanged   = 3
+	ReasonSuperseded           = 4
+	ReasonCessationOfOperation = 5
+	ReasonCertificateHold      = 6
+	ReasonRemoveFromCRL        = 8
+	ReasonPrivilegeWithdrawn   = 9
+	ReasonAACompromise         = 10
+)
+
+// GetExtFromList returns the extension with the matching OID from exts, if
+// present. If the extension is not present, it returns nil.
+func GetExtFromList(exts []pkix.Extension, oid asn1.ObjectIdentifier) *pkix.Extension {
+	for i := range exts {
+		if oid.Equal(exts[i].Id) {
+			return &(exts[i])
+		}
+	}
+	return nil
+}
+
+// IsExtInCRL returns true if the CRL itself (not one of its entries) carries
+// the extension with the matching OID.
+func IsExtInCRL(crl *pkix.CertificateList, oid asn1.ObjectIdentifier) bool {
+	return crl != nil && GetExtFromList(crl.TBSCertList.Extensions, oid) != nil
+}
+
+// IsExtInCRLEntries returns true if at least one entry of the CRL carries
+// the extension with the matching OID.
+func IsExtInCRLEntries(crl *pkix.CertificateList, oid asn1.ObjectIdentifier) bool {
+	for i := range crl.TBSCertList.RevokedCertificates {
+		if GetExtFromList(crl.TBSCertList.RevokedCertificates[i].Extensions, oid) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeltaCRL returns true if crl carries the deltaCRLIndicator extension.
+func IsDeltaCRL(crl *pkix.CertificateList) bool {
+	return IsExtInCRL(crl, DeltaCRLIndicatorOID)
+}
+
+// GetReasonCode returns the CRLReason of a CRL entry. ok is false when the
+// entry has no reasonCode extension.
+func GetReasonCode(entry *pkix.RevokedCertificate) (code int, ok bool, err error) {
+	ext := GetExtFromList(entry.Extensions, ReasonCodeOID)
+	if ext == nil {
+		return 0, false, nil
+	}
+	var reason asn1.Enumerated
+	rest, err := asn1.Unmarshal(ext.Value, &reason)
+	if err != nil {
+		return 0, true, err
+	}
+	if len(rest) != 0 {
+		return 0, true, errors.New("reasonCode: trailing data")
+	}
+	return int(reason), true, nil
+}
+
+// GetInvalidityDate returns the raw invalidityDate of a CRL entry along with
+// its decoded value. ok is false when the entry has no invalidityDate
+// extension.
+func GetInvalidityDate(entry *pkix.RevokedCertificate) (raw asn1.RawValue, date time.Time, ok bool, err error) {
+	ext := GetExtFromList(entry.Extensions, InvalidityDateOID)
+	if ext == nil {
+		return raw, date, false, nil
+	}
+	if _, err = asn1.Unmarshal(ext.Value, &raw); err != nil {
+		return raw, date, true, err
+	}
+	_, err = asn1.Unmarshal(ext.Value, &date)
+	return raw, date, true, err
+}
@@ -0,0 +1,248 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A TLV is one BER encoded element. Unlike encoding/asn1, which only accepts
+// DER, ReadTLV reads the encodings that BER allows and DER forbids, and
+// records that it met them.
+type TLV struct {
+	Class       int
+	Tag         int
+	Constructed bool
+	// Content holds the contents octets. For an indefinite length, it
+	// excludes the end-of-contents octets.
+	Content []byte
+	// Full holds the whole encoding, including the identifier and length.
+	Full []byte
+	// Offset is the position of the element in the data being walked.
+	Offset int
+
+	// IndefiniteLength is set if the length is encoded in the indefinite
+	// form.
+	IndefiniteLength bool
+	// NonMinimalLength is set if the length is encoded in more octets than
+	// it needs, or in the long form when the short form would do.
+	NonMinimalLength bool
+}
+
+// ReadTLV reads the first element of b and returns it with the bytes that
+// follow it.
+func ReadTLV(b []byte) (TLV, []byte, error) {
+	var t TLV
+	if len(b) < 2 {
+		return t, nil, errors.New("truncated element")
+	}
+	t.Class = int(b[0] >> 6)
+	t.Constructed = b[0]&0x20 != 0
+	t.Tag = int(b[0] & 0x1f)
+	i := 1
+	if t.Tag == 0x1f {
+		// High tag number form, base 128 with the high bit marking
+		// continuation.
+		t.Tag = 0
+		for {
+			if i >= len(b) {
+				return t, nil, errors.New("truncated tag")
+			}
+			if t.Tag > 1<<24 {
+				return t, nil, errors.New("tag too large")
+			}
+			t.Tag = t.Tag<<7 | int(b[i]&0x7f)
+			i++
+			if b[i-1]&0x80 == 0 {
+				break
+			}
+		}
+	}
+	if i >= len(b) {
+		return t, nil, errors.New("truncated length")
+	}
+	l := int(b[i])
+	i++
+	switch {
+	case l == 0x80:
+		if !t.Constructed {
+			return t, nil, errors.New("indefinite length on a primitive element")
+		}
+		t.IndefiniteLength = true
+		rest := b[i:]
+		for {
+			if len(rest) >= 2 && rest[0] == 0 && rest[1] == 0 {
+				break
+			}
+			_, next, err := ReadTLV(rest)
+			if err != nil {
+				return t, nil, err
+			}
+			rest = next
+		}
+		end := len(b) - len(rest)
+		t.Content = b[i:end]
+		t.Full = b[:end+2]
+		return t, rest[2:], nil
+	case l > 0x80:
+		n := l & 0x7f
+		if n > 4 || i+n > len(b) {
+			return t, nil, errors.New("bad length")
+		}
+		if b[i] == 0 {
+			t.NonMinimalLength = true
+		}
+		l = 0
+		for _, o := range b[i : i+n] {
+			l = l<<8 | int(o)
+		}
+		if l < 0x80 {
+			t.NonMinimalLength = true
+		}
+		i += n
+	}
+	if l < 0 || i+l > len(b) {
+		return t, nil, errors.New("length exceeds data")
+	}
+	t.Content = b[i : i+l]
+	t.Full = b[:i+l]
+	return t, b[i+l:], nil
+}
+
+// RawValue returns t as encoding/asn1 would decode it into an
+// asn1.RawValue.
+func (t TLV) RawValue() asn1.RawValue {
+	return asn1.RawValue{Class: t.Class, Tag: t.Tag, IsCompound: t.Constructed, Bytes: t.Content, FullBytes: t.Full}
+}
+
+// SequenceElements reads the elements of the constructed element t.
+func SequenceElements(t TLV) ([]TLV, error) {
+	var out []TLV
+	rest := t.Content
+	for len(rest) > 0 {
+		off := len(t.Content) - len(rest)
+		e, next, err := ReadTLV(rest)
+		if err != nil {
+			return nil, err
+		}
+		e.Offset = t.Offset + len(t.Full) - len(t.Content) + off
+		out = append(out, e)
+		rest = next
+	}
+	return out, nil
+}
+
+// WalkTLV calls fn for each element encoded in b, and every element nested
+// in them, in the order they are encoded.
+func WalkTLV(b []byte, fn func(TLV)) error {
+	for off := 0; off < len(b); {
+		t, _, err := ReadTLV(b[off:])
+		if err != nil {
+			return fmt.Errorf("at offset %d: %s", off, err)
+		}
+		t.Offset = off
+		fn(t)
+		if t.Constructed {
+			start := off + len(t.Full) - len(t.Content)
+			if t.IndefiniteLength {
+				start -= 2
+			}
+			err := WalkTLV(t.Content, func(inner TLV) {
+				inner.Offset += start
+				fn(inner)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		off += len(t.Full)
+	}
+	return nil
+}
+
+// A TBSCertificate holds the fields of a TBSCertificate as they are encoded.
+// The OPTIONAL fields are nil when absent.
+type TBSCertificate struct {
+	Version              *TLV
+	SerialNumber         TLV
+	Signature            TLV
+	Issuer               TLV
+	Validity             TLV
+	Subject              TLV
+	SubjectPublicKeyInfo TLV
+	IssuerUniqueID       *TLV
+	SubjectUniqueID      *TLV
+	Extensions           *TLV
+	// Trailing holds the elements that follow the last field.
+	Trailing []TLV
+}
+
+// ParseTBSCertificate splits a TBSCertificate, such as the
+// RawTBSCertificate of a certificate, into its fields.
+func ParseTBSCertificate(raw []byte) (*TBSCertificate, error) {
+	seq, rest, err := ReadTLV(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after TBSCertificate")
+	}
+	elems, err := SequenceElements(seq)
+	if err != nil {
+		return nil, err
+	}
+	tbs := new(TBSCertificate)
+	if len(elems) > 0 && elems[0].Class == asn1.ClassContextSpecific && elems[0].Tag == 0 {
+		tbs.Version = &elems[0]
+		elems = elems[1:]
+	}
+	if len(elems) < 6 {
+		return nil, errors.New("TBSCertificate is missing fields")
+	}
+	tbs.SerialNumber, tbs.Signature, tbs.Issuer = elems[0], elems[1], elems[2]
+	tbs.Validity, tbs.Subject, tbs.SubjectPublicKeyInfo = elems[3], elems[4], elems[5]
+	elems = elems[6:]
+	for _, f := range []struct {
+		tag   int
+		field **TLV
+	}{{1, &tbs.IssuerUniqueID}, {2, &tbs.SubjectUniqueID}, {3, &tbs.Extensions}} {
+		if len(elems) > 0 && elems[0].Class == asn1.ClassContextSpecific && elems[0].Tag == f.tag {
+			*f.field = &elems[0]
+			elems = elems[1:]
+		}
+	}
+	tbs.Trailing = elems
+	return tbs, nil
+}
+
+// WalkCertificateDER calls fn for every element of the TBSCertificate of c
+// and, since encoding/asn1 only checks the ones it decodes, every element
+// inside its extension values. Offsets inside an extension value are
+// relative to the value. An extension value is walked up to the first
+// element that cannot be read; a value that is not BER at all is left to
+// the lints for that extension.
+func WalkCertificateDER(c *x509.Certificate, fn func(TLV)) error {
+	if err := WalkTLV(c.RawTBSCertificate, fn); err != nil {
+		return err
+	}
+	for _, ext := range c.Extensions {
+		WalkTLV(ext.Value, fn)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"testing"
+)
+
+func TestReadTLVNonMinimalLength(t *testing.T) {
+	for _, b := range [][]byte{
+		{0x04, 0x81, 0x01, 0xaa},
+		{0x04, 0x82, 0x00, 0x81, 0x01},
+	} {
+		tlv, _, err := ReadTLV(append(b, make([]byte, 0x81)...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tlv.NonMinimalLength {
+			t.Error("For", b, "expected a non-minimal length")
+		}
+	}
+	tlv, _, err := ReadTLV(append([]byte{0x04, 0x81, 0x80}, make([]byte, 0x80)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tlv.NonMinimalLength {
+		t.Error("expected a minimal length for 0x80 octets")
+	}
+}
+
+func TestWalkTLVIndefiniteLength(t *testing.T) {
+	// SEQUENCE (indefinite) { INTEGER 1, SEQUENCE { NULL } } INTEGER 2
+	b := []byte{0x30, 0x80, 0x02, 0x01, 0x01, 0x30, 0x02, 0x05, 0x00, 0x00, 0x00, 0x02, 0x01, 0x02}
+	var offsets []int
+	var indefinite int
+	err := WalkTLV(b, func(tlv TLV) {
+		offsets = append(offsets, tlv.Offset)
+		if tlv.IndefiniteLength {
+			indefinite++
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{0, 2, 5, 7, 11}
+	if len(offsets) != len(expected) {
+		t.Fatal("expected offsets", expected, "got", offsets)
+	}
+	for i := range expected {
+		if offsets[i] != expected[i] {
+			t.Error("expected offsets", expected, "got", offsets)
+		}
+	}
+	if indefinite != 1 {
+		t.Error("expected 1 indefinite length, got", indefinite)
+	}
+}
+
+func TestReadTLVTruncated(t *testing.T) {
+	for _, b := range [][]byte{{0x30}, {0x30, 0x05, 0x02}, {0x30, 0x80, 0x02, 0x01, 0x01}, {0x04, 0x80, 0x00, 0x00}} {
+		if _, _, err := ReadTLV(b); err == nil {
+			t.Error("For", b, "expected an error")
+		}
+	}
+}
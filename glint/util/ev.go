@@ -15,57 +15,192 @@
 package util
 
 import (
+	"bufio"
+	"bytes"
+	_ "embed"
 	"encoding/asn1"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
 )
 
-var evoids = map[string]bool{
-	"1.3.159.1.17.1":                   true,
-	"1.3.6.1.4.1.34697.2.1":            true,
-	"1.3.6.1.4.1.34697.2.2":            true,
-	"1.3.6.1.4.1.34697.2.3":            true,
-	"1.3.6.1.4.1.34697.2.4":            true,
-	"1.2.40.0.17.1.22":                 true,
-	"2.16.578.1.26.1.3.3":              true,
-	"1.3.6.1.4.1.17326.10.14.2.1.2":    true,
-	"1.3.6.1.4.1.17326.10.8.2.1.2":     true,
-	"1.3.6.1.4.1.6449.1.2.1.5.1":       true,
-	"2.16.840.1.114412.2.1":            true,
-	"2.16.840.1.114412.1.3.0.2":        true,
-	"2.16.528.1.1001.1.1.1.12.6.1.1.1": true,
-	"2.16.792.3.0.4.1.1.4":             true,
-	"2.16.840.1.114028.10.1.2":         true,
-	"0.4.0.2042.1.4":                   true,
-	"0.4.0.2042.1.5":                   true,
-	"1.3.6.1.4.1.13177.10.1.3.10":      true,
-	"1.3.6.1.4.1.14370.1.6":            true,
-	"1.3.6.1.4.1.4146.1.1":             true,
-	"2.16.840.1.114413.1.7.23.3":       true,
-	"1.3.6.1.4.1.14777.6.1.1":          true,
-	"2.16.792.1.2.1.1.5.7.1.9":         true,
-	"1.3.6.1.4.1.782.1.2.1.8.1":        true,
-	"1.3.6.1.4.1.22234.2.5.2.3.1":      true,
-	"1.3.6.1.4.1.8024.0.2.100.1.2":     true,
-	"1.2.392.200091.100.721.1":         true,
-	"2.16.840.1.114414.1.7.23.3":       true,
-	"1.3.6.1.4.1.23223.2":              true,
-	"1.3.6.1.4.1.23223.1.1.1":          true,
-	"2.16.756.1.83.21.0":               true,
-	"2.16.756.1.89.1.2.1.1":            true,
-	"1.3.6.1.4.1.7879.13.24.1":         true,
-	"2.16.840.1.113733.1.7.48.1":       true,
-	"2.16.840.1.114404.1.1.2.4.1":      true,
-	"2.16.840.1.113733.1.7.23.6":       true,
-	"1.3.6.1.4.1.6334.1.100.1":         true,
-	"2.16.840.1.114171.500.9":          true,
-	"1.3.6.1.4.1.36305.2":              true,
-}
-
-// IsEV returns true if the input is a known Extended Validation OID.
-func IsEV(in []asn1.ObjectIdentifier) bool {
+// EVUsage is the kind of certificate an EV policy OID is asserted in.
+type EVUsage int
+
+const (
+	EVTLS EVUsage = 1 << iota
+	EVCodeSigning
+)
+
+// An EVPolicy is one entry of an EVRegistry.
+type EVPolicy struct {
+	OID   string
+	Owner string
+	Usage EVUsage
+}
+
+// An EVRegistry is a set of Extended Validation policy OIDs, recording the
+// CA that defined each one and whether it is used for TLS, code signing or
+// both.
+type EVRegistry struct {
+	policies map[string]EVPolicy
+}
+
+// NewEVRegistry returns an empty registry.
+func NewEVRegistry() *EVRegistry {
+	return &EVRegistry{policies: make(map[string]EVPolicy)}
+}
+
+// Len returns the number of OIDs in r.
+func (r *EVRegistry) Len() int {
+	return len(r.policies)
+}
+
+// Append reads policies from rd, one "oid,owner,usage" line each, where
+// usage is "tls", "codesigning" or "tls+codesigning". Blank lines and lines
+// starting with # are skipped. An OID that is already in r is replaced.
+func (r *EVRegistry) Append(rd io.Reader) error {
+	scanner := bufio.NewScanner(rd)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) != 3 {
+			return fmt.Errorf("line %d: expected oid,owner,usage", n)
+		}
+		p := EVPolicy{OID: strings.TrimSpace(fields[0]), Owner: strings.TrimSpace(fields[1])}
+		for _, u := range strings.Split(fields[2], "+") {
+			switch strings.TrimSpace(u) {
+			case "tls":
+				p.Usage |= EVTLS
+			case "codesigning":
+				p.Usage |= EVCodeSigning
+			default:
+				return fmt.Errorf("line %d: unknown usage %q", n, u)
+			}
+		}
+		r.policies[p.OID] = p
+	}
+	return scanner.Err()
+}
+
+// AppendFromPath reads policies from the file at path.
+func (r *EVRegistry) AppendFromPath(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.Append(f)
+}
+
+// Lookup returns the policy registered for oid.
+func (r *EVRegistry) Lookup(oid asn1.ObjectIdentifier) (EVPolicy, bool) {
+	p, ok := r.policies[oid.String()]
+	return p, ok
+}
+
+// Asserts returns true if any of in is registered for usage.
+func (r *EVRegistry) Asserts(in []asn1.ObjectIdentifier, usage EVUsage) bool {
 	for _, oid := range in {
-		if _, ok := evoids[oid.String()]; ok {
+		if p, ok := r.Lookup(oid); ok && p.Usage&usage != 0 {
 			return true
 		}
 	}
 	return false
 }
+
+//go:embed data/ev_policy_oids.csv
+var evPolicyOIDs []byte
+
+var defaultEVRegistry = mustLoadEVRegistry(evPolicyOIDs)
+
+func mustLoadEVRegistry(data []byte) *EVRegistry {
+	r := NewEVRegistry()
+	if err := r.Append(bytes.NewReader(data)); err != nil {
+		panic("invalid EV policy OID registry: " + err.Error())
+	}
+	return r
+}
+
+// DefaultEVRegistry returns the registry consulted by IsEV and
+// IsEVCodeSigning. It starts out as the registry built into glint.
+func DefaultEVRegistry() *EVRegistry {
+	return defaultEVRegistry
+}
+
+// SetDefaultEVRegistry replaces the registry consulted by IsEV and
+// IsEVCodeSigning. It is normally called once, before linting.
+func SetDefaultEVRegistry(r *EVRegistry) {
+	defaultEVRegistry = r
+}
+
+// IsEV returns true if the input is a known Extended Validation OID for TLS.
+func IsEV(in []asn1.ObjectIdentifier) bool {
+	return DefaultEVRegistry().Asserts(in, EVTLS)
+}
+
+// IsEVCodeSigning returns true if the input is a known Extended Validation
+// OID for code signing, such as the CA/Browser Forum reserved 2.23.140.1.3.
+func IsEVCodeSigning(in []asn1.ObjectIdentifier) bool {
+	return DefaultEVRegistry().Asserts(in, EVCodeSigning)
+}
+
+// EVDetection selects which policy OIDs make IsEVCert treat a certificate
+// as EV.
+type EVDetection int
+
+const (
+	// EVDetectCodeSigning uses IsEVCodeSigning. It is the default.
+	EVDetectCodeSigning EVDetection = iota
+	// EVDetectTLS uses IsEV, the TLS EV policy OIDs.
+	EVDetectTLS
+	// EVDetectCompare accepts either, so that the lints can report the
+	// certificates on which the two disagree.
+	EVDetectCompare
+)
+
+var evDetectionNames = map[string]EVDetection{
+	"codesigning": EVDetectCodeSigning,
+	"tls":         EVDetectTLS,
+	"compare":     EVDetectCompare,
+}
+
+// ParseEVDetection parses "codesigning", "tls" or "compare".
+func ParseEVDetection(s string) (EVDetection, error) {
+	d, ok := evDetectionNames[s]
+	if !ok {
+		return EVDetectCodeSigning, fmt.Errorf("unknown EV detection mode %q", s)
+	}
+	return d, nil
+}
+
+var evDetection = EVDetectCodeSigning
+
+// GetEVDetection returns the mode used by IsEVCert.
+func GetEVDetection() EVDetection {
+	return evDetection
+}
+
+// SetEVDetection sets the mode used by IsEVCert. It is normally called once,
+// before linting.
+func SetEVDetection(d EVDetection) {
+	evDetection = d
+}
+
+// IsEVCert returns true if c asserts an EV policy OID, as selected by the
+// EV detection mode.
+func IsEVCert(c *x509.Certificate) bool {
+	switch evDetection {
+	case EVDetectTLS:
+		return IsEV(c.PolicyIdentifiers)
+	case EVDetectCompare:
+		return IsEV(c.PolicyIdentifiers) || IsEVCodeSigning(c.PolicyIdentifiers)
+	}
+	return IsEVCodeSigning(c.PolicyIdentifiers)
+}
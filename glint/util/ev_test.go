@@ -0,0 +1,79 @@
+/*
+ * ZLint Copyright 2018 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+	"strings"
+	"testing"
+)
+
+func TestDefaultEVRegistry(t *testing.T) {
+	cases := []struct {
+		oid         asn1.ObjectIdentifier
+		tls         bool
+		codeSigning bool
+	}{
+		{BRAssertComplianceEVOID, false, true},
+		{BRExtendedValidatedOID, true, false},
+		{sectigoEVCodeSigning, false, true},
+		{verisignEVCodeSigning, true, true},
+		{asn1.ObjectIdentifier{2, 16, 840, 1, 114412, 2, 1}, true, false},
+		{BRAssertComplianceOID, false, false},
+	}
+	for _, c := range cases {
+		in := []asn1.ObjectIdentifier{c.oid}
+		if got := IsEV(in); got != c.tls {
+			t.Errorf("IsEV(%s) = %t, want %t", c.oid, got, c.tls)
+		}
+		if got := IsEVCodeSigning(in); got != c.codeSigning {
+			t.Errorf("IsEVCodeSigning(%s) = %t, want %t", c.oid, got, c.codeSigning)
+		}
+	}
+}
+
+func TestEVRegistryAppend(t *testing.T) {
+	r := NewEVRegistry()
+	data := "# comment\n\n1.2.3,Example CA,codesigning\n1.2.4, Example CA ,tls+codesigning\n"
+	if err := r.Append(strings.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if r.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", r.Len())
+	}
+	p, ok := r.Lookup(asn1.ObjectIdentifier{1, 2, 4})
+	if !ok || p.Owner != "Example CA" || p.Usage != EVTLS|EVCodeSigning {
+		t.Errorf("Lookup(1.2.4) = %+v, %t", p, ok)
+	}
+}
+
+func TestEVRegistryAppendInvalid(t *testing.T) {
+	for _, data := range []string{"1.2.3,Example CA\n", "1.2.3,Example CA,smime\n"} {
+		if err := NewEVRegistry().Append(strings.NewReader(data)); err == nil {
+			t.Errorf("Append(%q) succeeded", data)
+		}
+	}
+}
+
+func TestParseEVDetection(t *testing.T) {
+	for s, want := range map[string]EVDetection{"codesigning": EVDetectCodeSigning, "tls": EVDetectTLS, "compare": EVDetectCompare} {
+		if got, err := ParseEVDetection(s); err != nil || got != want {
+			t.Errorf("ParseEVDetection(%q) = %d, %v", s, got, err)
+		}
+	}
+	if _, err := ParseEVDetection("both"); err == nil {
+		t.Error("ParseEVDetection(\"both\") succeeded")
+	}
+}
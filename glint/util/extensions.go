@@ -0,0 +1,106 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"hash"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A KnownExtension is a certificate extension that relying parties are
+// expected to recognize.
+type KnownExtension struct {
+	Name string
+	// NonEmptySequence is set for extensions whose value is a SEQUENCE SIZE
+	// (1..MAX), which MUST NOT be empty.
+	NonEmptySequence bool
+}
+
+// knownExtensions is the registry of certificate extensions, keyed by
+// dotted OID.
+var knownExtensions = map[string]KnownExtension{
+	AiaOID.String():                  {"authorityInfoAccess", true},
+	AuthkeyOID.String():              {"authorityKeyIdentifier", false},
+	BasicConstOID.String():           {"basicConstraints", false},
+	CertPolicyOID.String():           {"certificatePolicies", true},
+	CrlDistOID.String():              {"cRLDistributionPoints", true},
+	CtPoisonOID.String():             {"ctPoison", false},
+	EkuSynOid.String():               {"extKeyUsage", true},
+	FreshCRLOID.String():             {"freshestCRL", true},
+	InhibitAnyPolicyOID.String():     {"inhibitAnyPolicy", false},
+	IssuerAlternateNameOID.String():  {"issuerAltName", true},
+	KeyUsageOID.String():             {"keyUsage", false},
+	LogoTypeOID.String():             {"logotype", false},
+	NameConstOID.String():            {"nameConstraints", true},
+	OscpNoCheckOID.String():          {"ocspNoCheck", false},
+	PolicyConstOID.String():          {"policyConstraints", true},
+	PolicyMapOID.String():            {"policyMappings", true},
+	PrivKeyUsageOID.String():         {"privateKeyUsagePeriod", false},
+	QcStateOid.String():              {"qcStatements", true},
+	TimestampOID.String():            {"signedCertificateTimestampList", false},
+	SmimeOID.String():                {"smimeCapabilities", false},
+	SubjectAlternateNameOID.String(): {"subjectAltName", true},
+	SubjectDirAttrOID.String():       {"subjectDirectoryAttributes", true},
+	SubjectInfoAccessOID.String():    {"subjectInfoAccess", true},
+	SubjectKeyIdentityOID.String():   {"subjectKeyIdentifier", false},
+	"1.3.6.1.5.5.7.1.24":             {"tlsFeature", true},
+	"2.16.840.1.113730.1.1":          {"netscapeCertType", false},
+	// Microsoft extensions found in code signing hierarchies.
+	"1.3.6.1.4.1.311.20.2":  {"certificateTemplateName", false},
+	"1.3.6.1.4.1.311.21.1":  {"caVersion", false},
+	"1.3.6.1.4.1.311.21.2":  {"previousCACertificateHash", false},
+	"1.3.6.1.4.1.311.21.7":  {"certificateTemplate", false},
+	"1.3.6.1.4.1.311.21.10": {"applicationCertPolicies", true},
+}
+
+// GetKnownExtension returns the registry entry for the extension oid and
+// whether there is one.
+func GetKnownExtension(oid asn1.ObjectIdentifier) (KnownExtension, bool) {
+	ext, ok := knownExtensions[oid.String()]
+	return ext, ok
+}
+
+// KeyIdentifierCandidates returns the key identifiers of the public key of c
+// computed by the methods of RFC 5280 4.2.1.2 and RFC 7093 section 2.
+func KeyIdentifierCandidates(c *x509.Certificate) [][]byte {
+	var spki struct {
+		Algorithm asn1.RawValue
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil
+	}
+	key := spki.PublicKey.RightAlign()
+	sum := func(h hash.Hash, b []byte) []byte {
+		h.Write(b)
+		return h.Sum(nil)
+	}
+	method1 := sum(sha1.New(), key)
+	method2 := append([]byte{0x40 | method1[12]&0x0f}, method1[13:]...)
+	return [][]byte{
+		method1,
+		method2,
+		sum(sha256.New(), key)[:20],
+		sum(sha512.New384(), key)[:20],
+		sum(sha512.New(), key)[:20],
+		sum(sha1.New(), c.RawSubjectPublicKeyInfo),
+		sum(sha256.New(), c.RawSubjectPublicKeyInfo),
+	}
+}
@@ -62,3 +62,103 @@ func NotAllNameFieldsAreEmpty(name *pkix.Name) bool {
 	//Return true if at least one field is non-empty
 	return len(name.Names) >= 1
 }
+
+// Attributes outside id-at that the CA/Browser Forum requirements and common
+// practice place in subject names, such as the EV jurisdiction attributes.
+var extraSubjectAttributes = []asn1.ObjectIdentifier{
+	BusinessOID,
+	{2, 5, 4, 65},                // id-at-pseudonym
+	{2, 5, 4, 97},                // id-at-organizationIdentifier
+	{1, 2, 840, 113549, 1, 9, 1}, // emailAddress
+	DomainComponentOID,
+	jurisdictionLocalityNameOID,
+	jurisdictionStateOrProvinceName,
+	jurisdictionCountryName,
+}
+
+// IsSubjectAttribute returns true if oid is a name attribute, or one of the
+// attributes besides them that subject names of publicly-trusted
+// certificates carry.
+func IsSubjectAttribute(oid asn1.ObjectIdentifier) bool {
+	return IsNameAttribute(oid) || SliceContainsOID(extraSubjectAttributes, oid)
+}
+
+// IsRepeatableNameAttribute returns true for the attribute types that are
+// expected to appear more than once in a name: organizationalUnitName,
+// streetAddress and domainComponent, whose values are the labels of a
+// domain name.
+func IsRepeatableNameAttribute(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(OrganizationalUnitNameOID) || oid.Equal(StreetAddressOID) || oid.Equal(DomainComponentOID)
+}
+
+// DuplicateNameAttributes returns, in order of first repetition, the
+// attribute types that appear more than once across the RDNs of seq and are
+// not repeatable.
+func DuplicateNameAttributes(seq pkix.RDNSequence) []asn1.ObjectIdentifier {
+	var seen, dups []asn1.ObjectIdentifier
+	for _, rdn := range seq {
+		for _, atv := range rdn {
+			if IsRepeatableNameAttribute(atv.Type) {
+				continue
+			}
+			if !SliceContainsOID(seen, atv.Type) {
+				seen = append(seen, atv.Type)
+			} else if !SliceContainsOID(dups, atv.Type) {
+				dups = append(dups, atv.Type)
+			}
+		}
+	}
+	return dups
+}
+
+// MultiValuedRDNs returns the RDNs of seq that hold more than one attribute.
+func MultiValuedRDNs(seq pkix.RDNSequence) []pkix.RelativeDistinguishedNameSET {
+	var out []pkix.RelativeDistinguishedNameSET
+	for _, rdn := range seq {
+		if len(rdn) > 1 {
+			out = append(out, rdn)
+		}
+	}
+	return out
+}
+
+// The conventional order of name attributes, from the most general to the
+// most specific. Names are written in this order, as OpenSSL does, or in
+// reverse, as LDAP does.
+var nameAttributeOrder = []asn1.ObjectIdentifier{
+	CountryNameOID,
+	StateOrProvinceNameOID,
+	LocalityNameOID,
+	OrganizationNameOID,
+	OrganizationalUnitNameOID,
+	CommonNameOID,
+}
+
+// NameAttributesOrdered returns true if the attributes of seq whose place is
+// conventional appear in that order or in its reverse. Other attributes may
+// appear anywhere.
+func NameAttributesOrdered(seq pkix.RDNSequence) bool {
+	ascending, descending := true, true
+	last := -1
+	for _, rdn := range seq {
+		for _, atv := range rdn {
+			rank := -1
+			for i, oid := range nameAttributeOrder {
+				if oid.Equal(atv.Type) {
+					rank = i
+				}
+			}
+			if rank < 0 {
+				continue
+			}
+			if last >= 0 && rank < last {
+				ascending = false
+			}
+			if last >= 0 && rank > last {
+				descending = false
+			}
+			last = rank
+		}
+	}
+	return ascending || descending
+}
@@ -0,0 +1,102 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// contains helper functions for OCSP response lints
+
+package util
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
+)
+
+// GetOCSPResponderCert returns the certificate embedded in resp that the
+// ResponderID of RFC 6960: 4.2.2.3 names, either by subject name or by the
+// SHA-1 hash of its public key. It returns nil if no embedded certificate
+// matches.
+func GetOCSPResponderCert(resp *ocsp.BasicOCSPResponse) (*x509.Certificate, error) {
+	id := resp.TBSResponseData.RawResponderID
+	for _, raw := range resp.Certs {
+		cert, err := x509.ParseCertificate(raw.FullBytes)
+		if err != nil {
+			return nil, err
+		}
+		switch id.Tag {
+		case 1: // byName
+			if bytes.Equal(id.Bytes, cert.RawSubject) {
+				return cert, nil
+			}
+		case 2: // byKey
+			var keyHash []byte
+			if _, err := asn1.Unmarshal(id.Bytes, &keyHash); err != nil {
+				return nil, err
+			}
+			if hash, ok := publicKeySHA1(cert); ok && bytes.Equal(keyHash, hash) {
+				return cert, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// publicKeySHA1 returns the SHA-1 hash of the subjectPublicKey BIT STRING of
+// c, excluding the tag, length and number of unused bits.
+func publicKeySHA1(c *x509.Certificate) ([]byte, bool) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, false
+	}
+	hash := sha1.Sum(spki.PublicKey.RightAlign())
+	return hash[:], true
+}
+
+// HasOCSPResponderCert returns true if resp embeds the certificate of a
+// delegated responder: a certificate named by the ResponderID that is not
+// itself a CA certificate. A response signed by the issuing CA may embed the
+// CA certificate, which needs neither id-kp-OCSPSigning nor
+// id-pkix-ocsp-nocheck.
+func HasOCSPResponderCert(resp *ocsp.BasicOCSPResponse) bool {
+	cert, err := GetOCSPResponderCert(resp)
+	return cert != nil && err == nil && !IsCACert(cert)
+}
+
+// IsOCSPRevoked returns true if the certStatus of single is revoked.
+func IsOCSPRevoked(single *ocsp.SingleResponse) bool {
+	return !single.Revoked.RevocationTime.IsZero()
+}
+
+// CountOCSPCertStatus returns how many of the good, revoked and unknown
+// alternatives of the certStatus CHOICE are present in single. A well-formed
+// response has exactly one.
+func CountOCSPCertStatus(single *ocsp.SingleResponse) int {
+	count := 0
+	if single.Good {
+		count++
+	}
+	if IsOCSPRevoked(single) {
+		count++
+	}
+	if single.Unknown {
+		count++
+	}
+	return count
+}
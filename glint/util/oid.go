@@ -49,12 +49,19 @@ var (
 	SubjectDirAttrOID       = asn1.ObjectIdentifier{2, 5, 29, 9}                      // Subject Directory Attributes
 	SubjectInfoAccessOID    = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 11}       // Subject Info Access Syntax
 	SubjectKeyIdentityOID   = asn1.ObjectIdentifier{2, 5, 29, 14}                     // Subject Key Identifier
+	// CRL and CRL entry extension OIDs
+	CRLNumberOID                = asn1.ObjectIdentifier{2, 5, 29, 20} // CRL Number
+	ReasonCodeOID               = asn1.ObjectIdentifier{2, 5, 29, 21} // CRL Entry Reason Code
+	InvalidityDateOID           = asn1.ObjectIdentifier{2, 5, 29, 24} // CRL Entry Invalidity Date
+	DeltaCRLIndicatorOID        = asn1.ObjectIdentifier{2, 5, 29, 27} // Delta CRL Indicator
+	IssuingDistributionPointOID = asn1.ObjectIdentifier{2, 5, 29, 28} // Issuing Distribution Point
 	// CA/B reserved policies
 	BRAssertComplianceOID      = asn1.ObjectIdentifier{2, 23, 140, 1, 4, 1} // Only for use by CA's for Non-EV Code Signing Certificates
 	BRAssertComplianceEVOID    = asn1.ObjectIdentifier{2, 23, 140, 1, 3}    // Only for use by CA's for EV Code Signing Certificates
 	BRDomainValidatedOID       = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1} // CA/B BR Domain-Validated
 	BROrganizationValidatedOID = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 2} // CA/B BR Organization-Validated
 	BRIndividualValidatedOID   = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 3} // CA/B BR Individual-Validated
+	BRExtendedValidatedOID     = asn1.ObjectIdentifier{2, 23, 140, 1, 1}    // CA/B EV Guidelines TLS
 	//X.500 attribute types
 	CommonNameOID             = asn1.ObjectIdentifier{2, 5, 4, 3}
 	SurnameOID                = asn1.ObjectIdentifier{2, 5, 4, 4}
@@ -68,6 +75,7 @@ var (
 	BusinessOID               = asn1.ObjectIdentifier{2, 5, 4, 15}
 	PostalCodeOID             = asn1.ObjectIdentifier{2, 5, 4, 17}
 	GivenNameOID              = asn1.ObjectIdentifier{2, 5, 4, 42}
+	DomainComponentOID        = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 25}
 	// Subject Jurisdiction of Incorporation or Registration Certificate Fields
 	jurisdictionLocalityNameOID     = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 60, 2, 1, 1}
 	jurisdictionStateOrProvinceName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 60, 2, 1, 2}
@@ -0,0 +1,94 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A PolicyQualifier is one qualifier of a certificate policy, with the
+// qualifier kept as it is encoded.
+type PolicyQualifier struct {
+	Policy    asn1.ObjectIdentifier
+	ID        asn1.ObjectIdentifier
+	Qualifier asn1.RawValue
+}
+
+type rawPolicyInformation struct {
+	Policy     asn1.ObjectIdentifier
+	Qualifiers []rawPolicyQualifierInfo `asn1:"optional"`
+}
+
+type rawPolicyQualifierInfo struct {
+	ID        asn1.ObjectIdentifier
+	Qualifier asn1.RawValue `asn1:"optional"`
+}
+
+// GetPolicyQualifiers returns every policy qualifier in the certificate
+// policies extension of c, in the order they are encoded. zcrypto keeps the
+// CPS URIs of c only as strings, which loses their encoding.
+func GetPolicyQualifiers(c *x509.Certificate) ([]PolicyQualifier, error) {
+	ext := GetExtFromCert(c, CertPolicyOID)
+	if ext == nil {
+		return nil, nil
+	}
+	var policies []rawPolicyInformation
+	if _, err := asn1.Unmarshal(ext.Value, &policies); err != nil {
+		return nil, err
+	}
+	var out []PolicyQualifier
+	for _, p := range policies {
+		for _, q := range p.Qualifiers {
+			out = append(out, PolicyQualifier{Policy: p.Policy, ID: q.ID, Qualifier: q.Qualifier})
+		}
+	}
+	return out, nil
+}
+
+// IsTLSPolicyOID returns true if oid is one of the CA/Browser Forum policy
+// OIDs reserved for TLS server certificates.
+func IsTLSPolicyOID(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(BRExtendedValidatedOID) ||
+		len(oid) == 6 && oid[:5].Equal(asn1.ObjectIdentifier{2, 23, 140, 1, 2})
+}
+
+// IsIndividualCodeSigningCert returns true if c is a Subscriber code
+// signing certificate issued to a natural person: a non-EV certificate
+// without organizationName that names the Subject with givenName or surname,
+// or that asserts the Individual-Validated policy. As for CSRs, the policy
+// for code signing is not required, since certificates issued before it
+// existed do not carry it.
+func IsIndividualCodeSigningCert(c *x509.Certificate) bool {
+	if !IsSubscriberCert(c) || IsEVCert(c) || len(c.Subject.Organization) > 0 {
+		return false
+	}
+	return len(c.Subject.GivenName) > 0 || len(c.Subject.Surname) > 0 ||
+		SliceContainsOID(c.PolicyIdentifiers, BRIndividualValidatedOID)
+}
+
+// IsOrganizationCodeSigningCert returns true if c is a Subscriber code
+// signing certificate issued to an organization: an EV code signing
+// certificate, or a non-EV one whose subject names an organization.
+func IsOrganizationCodeSigningCert(c *x509.Certificate) bool {
+	if !IsSubscriberCert(c) {
+		return false
+	}
+	if IsEVCert(c) {
+		return true
+	}
+	return SliceContainsOID(c.PolicyIdentifiers, BRAssertComplianceOID) && len(c.Subject.Organization) > 0
+}
@@ -14,7 +14,13 @@
 
 package util
 
-import "encoding/asn1"
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
 
 type AttributeTypeAndRawValue struct {
 	Type  asn1.ObjectIdentifier
@@ -24,3 +30,161 @@ type AttributeTypeAndRawValue struct {
 type AttributeTypeAndRawValueSET []AttributeTypeAndRawValue
 
 type RawRDNSequence []AttributeTypeAndRawValueSET
+
+// ParseRawRDNSequence parses a DER encoded Name, such as the RawSubject of a
+// certificate, keeping every attribute value as it is encoded.
+func ParseRawRDNSequence(raw []byte) (RawRDNSequence, error) {
+	var seq RawRDNSequence
+	rest, err := asn1.Unmarshal(raw, &seq)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after Name")
+	}
+	return seq, nil
+}
+
+// Attributes returns the attributes of every RDN of s, in order.
+func (s RawRDNSequence) Attributes() []AttributeTypeAndRawValue {
+	var out []AttributeTypeAndRawValue
+	for _, set := range s {
+		out = append(out, set...)
+	}
+	return out
+}
+
+// IsDirectoryString returns true if v is encoded as one of the string types
+// of the DirectoryString CHOICE, or as IA5String, which some attributes
+// such as emailAddress and domainComponent use.
+func IsDirectoryString(v asn1.RawValue) bool {
+	if v.Class != asn1.ClassUniversal || v.IsCompound {
+		return false
+	}
+	switch v.Tag {
+	case asn1.TagUTF8String, asn1.TagPrintableString, asn1.TagT61String,
+		asn1.TagBMPString, tagUniversalString, asn1.TagIA5String:
+		return true
+	}
+	return false
+}
+
+const tagUniversalString = 28
+
+// DecodeDirectoryString decodes the string attribute value v according to
+// its tag. TeletexString is decoded as Latin-1, as most implementations do.
+// It returns an error if v is not a string, or if its bytes are not valid
+// for its type.
+func DecodeDirectoryString(v asn1.RawValue) (string, error) {
+	if !IsDirectoryString(v) {
+		return "", fmt.Errorf("tag %d is not a string type", v.Tag)
+	}
+	switch v.Tag {
+	case asn1.TagUTF8String:
+		if !utf8.Valid(v.Bytes) {
+			return "", errors.New("invalid UTF-8 in UTF8String")
+		}
+		return string(v.Bytes), nil
+	case asn1.TagPrintableString:
+		for _, b := range v.Bytes {
+			if !isPrintableStringChar(b) {
+				return "", fmt.Errorf("invalid character %q in PrintableString", b)
+			}
+		}
+		return string(v.Bytes), nil
+	case asn1.TagIA5String:
+		if !IsIA5String(v.Bytes) {
+			return "", errors.New("invalid character in IA5String")
+		}
+		return string(v.Bytes), nil
+	case asn1.TagT61String:
+		runes := make([]rune, len(v.Bytes))
+		for i, b := range v.Bytes {
+			runes[i] = rune(b)
+		}
+		return string(runes), nil
+	case asn1.TagBMPString:
+		if len(v.Bytes)%2 != 0 {
+			return "", errors.New("odd length BMPString")
+		}
+		units := make([]uint16, len(v.Bytes)/2)
+		for i := range units {
+			units[i] = uint16(v.Bytes[2*i])<<8 | uint16(v.Bytes[2*i+1])
+			// BMPString is UCS-2, which has no surrogate pairs.
+			if utf16.IsSurrogate(rune(units[i])) {
+				return "", fmt.Errorf("surrogate U+%X in BMPString", units[i])
+			}
+		}
+		return string(utf16.Decode(units)), nil
+	}
+	// UniversalString
+	if len(v.Bytes)%4 != 0 {
+		return "", errors.New("UniversalString length is not a multiple of 4")
+	}
+	runes := make([]rune, len(v.Bytes)/4)
+	for i := range runes {
+		b := v.Bytes[4*i : 4*i+4]
+		runes[i] = rune(uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]))
+		if !utf8.ValidRune(runes[i]) {
+			return "", fmt.Errorf("invalid character U+%X in UniversalString", runes[i])
+		}
+	}
+	return string(runes), nil
+}
+
+// isPrintableStringChar returns true if b is in the PrintableString
+// character set of X.680.
+func isPrintableStringChar(b byte) bool {
+	switch {
+	case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9':
+		return true
+	}
+	switch b {
+	case ' ', '\'', '(', ')', '+', ',', '-', '.', '/', ':', '=', '?':
+		return true
+	}
+	return false
+}
+
+// An AttributeBound is the upper bound, in characters, on the value of a
+// name attribute.
+type AttributeBound struct {
+	Name string
+	Max  int
+}
+
+// attributeUpperBounds holds the upper bounds of RFC 5280 Appendix A and
+// X.520 for the name attributes that have one.
+var attributeUpperBounds = map[string]AttributeBound{
+	CommonNameOID.String():                   {"commonName", 64},
+	SurnameOID.String():                      {"surname", 32768},
+	SerialOID.String():                       {"serialNumber", 64},
+	CountryNameOID.String():                  {"countryName", 2},
+	LocalityNameOID.String():                 {"localityName", 128},
+	StateOrProvinceNameOID.String():          {"stateOrProvinceName", 128},
+	StreetAddressOID.String():                {"streetAddress", 128},
+	OrganizationNameOID.String():             {"organizationName", 64},
+	OrganizationalUnitNameOID.String():       {"organizationalUnitName", 64},
+	BusinessOID.String():                     {"businessCategory", 128},
+	PostalCodeOID.String():                   {"postalCode", 40},
+	GivenNameOID.String():                    {"givenName", 32768},
+	"2.5.4.12":                               {"title", 64},
+	"2.5.4.65":                               {"pseudonym", 128},
+	"1.2.840.113549.1.9.1":                   {"emailAddress", 255},
+	jurisdictionLocalityNameOID.String():     {"jurisdictionLocalityName", 128},
+	jurisdictionStateOrProvinceName.String(): {"jurisdictionStateOrProvinceName", 128},
+	jurisdictionCountryName.String():         {"jurisdictionCountryName", 2},
+}
+
+// AttributeUpperBound returns the upper bound on the value of the name
+// attribute oid, if it has one.
+func AttributeUpperBound(oid asn1.ObjectIdentifier) (AttributeBound, bool) {
+	b, ok := attributeUpperBounds[oid.String()]
+	return b, ok
+}
+
+// IsCountryNameAttribute returns true for countryName and
+// jurisdictionCountryName, which are PrintableStrings of two characters.
+func IsCountryNameAttribute(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(CountryNameOID) || oid.Equal(jurisdictionCountryName)
+}
@@ -0,0 +1,129 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A RegistrationNumberFormat is the format of the Registration Numbers that
+// the Incorporating or Registration Agency of a jurisdiction assigns.
+type RegistrationNumberFormat struct {
+	// Jurisdiction is an ISO 3166-1 country code or ISO 3166-2 subdivision
+	// code.
+	Jurisdiction string
+	Format       *regexp.Regexp
+	// Name describes the number, e.g. "Companies House company number".
+	Name string
+}
+
+//go:embed data/registration_numbers.tsv
+var registrationNumbers []byte
+
+var registrationNumberFormats = mustLoadRegistrationNumberFormats(registrationNumbers)
+
+func mustLoadRegistrationNumberFormats(data []byte) map[string]RegistrationNumberFormat {
+	out := make(map[string]RegistrationNumberFormat)
+	err := tsvLines(data, func(n int, fields []string) error {
+		if len(fields) != 3 {
+			return fmt.Errorf("line %d: expected jurisdiction<TAB>pattern<TAB>name", n)
+		}
+		format, err := regexp.Compile(`^(?:` + fields[1] + `)$`)
+		if err != nil {
+			return fmt.Errorf("line %d: %s", n, err)
+		}
+		out[fields[0]] = RegistrationNumberFormat{Jurisdiction: fields[0], Format: format, Name: fields[2]}
+		return nil
+	})
+	if err != nil {
+		panic("invalid registration number format list: " + err.Error())
+	}
+	return out
+}
+
+// GetRegistrationNumberFormat returns the Registration Number format of the
+// jurisdiction given by a jurisdictionCountryName and, if present, a
+// jurisdictionStateOrProvinceName. The format of the state or province is
+// preferred to that of the country.
+func GetRegistrationNumberFormat(country, province string) (RegistrationNumberFormat, bool) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if province != "" {
+		if s, ok := LookupSubdivision(country, province); ok {
+			if f, ok := registrationNumberFormats[s.Code]; ok {
+				return f, true
+			}
+			// An agency at the state or province level does not use the
+			// numbers of the national one.
+			return RegistrationNumberFormat{}, false
+		}
+	}
+	f, ok := registrationNumberFormats[country]
+	return f, ok
+}
+
+// registrationDate matches the common date formats that the EV Guidelines
+// allow in place of a Registration Number when the jurisdiction does not
+// issue one.
+var registrationDate = regexp.MustCompile(`^(?:\d{4}-\d{2}-\d{2}|\d{1,2}[./-]\d{1,2}[./-]\d{4})$`)
+
+// IsRegistrationDate returns true if v is a date of incorporation or
+// registration rather than a Registration Number.
+func IsRegistrationDate(v string) bool {
+	return registrationDate.MatchString(strings.TrimSpace(v))
+}
+
+var registrationPlaceholders = map[string]bool{
+	"N/A": true, "NA": true, "N.A.": true, "NONE": true, "NULL": true, "NIL": true,
+	"UNKNOWN": true, "NOT APPLICABLE": true, "NOT AVAILABLE": true, "TBD": true,
+	"-": true, ".": true, "?": true,
+}
+
+// IsPlaceholderRegistrationNumber returns true if v is a value that stands
+// in for a Registration Number rather than being one: a word such as "N/A",
+// a run of a single digit such as "0000", or a repeat of one of names, such
+// as the organizationName.
+func IsPlaceholderRegistrationNumber(v string, names ...string) bool {
+	v = strings.TrimSpace(v)
+	if registrationPlaceholders[strings.ToUpper(v)] {
+		return true
+	}
+	if v != "" && strings.Trim(v, v[:1]) == "" && strings.Contains("0123456789", v[:1]) {
+		return true
+	}
+	for _, n := range names {
+		if n = strings.TrimSpace(n); n != "" && strings.EqualFold(v, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBusinessCategory returns the subject:businessCategory of c, or "" if
+// it has none.
+func GetBusinessCategory(c *x509.Certificate) string {
+	for _, rdn := range c.Subject.ToRDNSequence() {
+		for _, atv := range rdn {
+			if v, ok := atv.Value.(string); ok && atv.Type.Equal(BusinessOID) {
+				return v
+			}
+		}
+	}
+	return ""
+}
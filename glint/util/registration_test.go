@@ -0,0 +1,61 @@
+/*
+ * ZLint Copyright 2018 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import "testing"
+
+func TestGetRegistrationNumberFormat(t *testing.T) {
+	cases := []struct {
+		country, province, want string
+	}{
+		{"US", "Delaware", "US-DE"},
+		{"US", "DE", "US-DE"},
+		{"us", "delaware", "US-DE"},
+		{"US", "Ohio", ""},
+		{"US", "", ""},
+		{"GB", "", "GB"},
+		{"DE", "Bayern", ""},
+		{"DE", "Nowhere", "DE"},
+	}
+	for _, c := range cases {
+		f, ok := GetRegistrationNumberFormat(c.country, c.province)
+		if ok != (c.want != "") || f.Jurisdiction != c.want {
+			t.Errorf("GetRegistrationNumberFormat(%q, %q) = %q, %t, want %q", c.country, c.province, f.Jurisdiction, ok, c.want)
+		}
+	}
+}
+
+func TestIsPlaceholderRegistrationNumber(t *testing.T) {
+	cases := []struct {
+		v    string
+		want bool
+	}{
+		{"N/A", true},
+		{" none ", true},
+		{"0000", true},
+		{"99999999", true},
+		{"Lint Test Ltd", true},
+		{"lint test ltd", true},
+		{"01234567", false},
+		{"HRB 123456", false},
+		{"Government Entity", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := IsPlaceholderRegistrationNumber(c.v, "Lint Test Ltd"); got != c.want {
+			t.Errorf("IsPlaceholderRegistrationNumber(%q) = %t, want %t", c.v, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"math/big"
+)
+
+// rocaPrimes are the small primes p for which the ROCA test checks that the
+// modulus reduced mod p lies in the subgroup generated by 65537.
+var rocaPrimes = []int64{
+	3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71,
+	73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139, 149, 151,
+	157, 163, 167,
+}
+
+// rocaSubgroups[i] holds the powers of 65537 mod rocaPrimes[i].
+var rocaSubgroups = func() []map[int64]bool {
+	out := make([]map[int64]bool, len(rocaPrimes))
+	for i, p := range rocaPrimes {
+		out[i] = make(map[int64]bool)
+		for x := int64(1); !out[i][x]; x = x * 65537 % p {
+			out[i][x] = true
+		}
+	}
+	return out
+}()
+
+// IsROCAVulnerable returns true if n has the structure of moduli generated
+// by the Infineon RSALib (CVE-2017-15361). Every prime factor of such a
+// modulus is of the form k*M + (65537^a mod M) for a primorial M, so n mod p
+// is a power of 65537 for each small prime p dividing M. A random modulus
+// passes this test with negligible probability.
+func IsROCAVulnerable(n *big.Int) bool {
+	mod := new(big.Int)
+	for i, p := range rocaPrimes {
+		if !rocaSubgroups[i][mod.Mod(n, big.NewInt(p)).Int64()] {
+			return false
+		}
+	}
+	return true
+}
+
+// FermatFactor tries to factor n with the given number of rounds of
+// Fermat's method, which succeeds quickly when the two prime factors of n
+// are close to each other. It returns nil if no factor was found.
+func FermatFactor(n *big.Int, rounds int) (p, q *big.Int) {
+	if n.Sign() <= 0 || n.Bit(0) == 0 {
+		return nil, nil
+	}
+	a := new(big.Int).Sqrt(n)
+	if new(big.Int).Mul(a, a).Cmp(n) < 0 {
+		a.Add(a, big.NewInt(1))
+	}
+	b2, b := new(big.Int), new(big.Int)
+	for i := 0; i < rounds; i++ {
+		b2.Mul(a, a).Sub(b2, n)
+		b.Sqrt(b2)
+		if new(big.Int).Mul(b, b).Cmp(b2) == 0 {
+			return new(big.Int).Sub(a, b), new(big.Int).Add(a, b)
+		}
+		a.Add(a, big.NewInt(1))
+	}
+	return nil, nil
+}
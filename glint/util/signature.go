@@ -0,0 +1,147 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+var (
+	// Signature algorithms whose AlgorithmIdentifier parameters MUST be NULL
+	// (RFC 3279: 2.2.1, RFC 4055: 5).
+	rsaPKCS1SigAlgOIDs = []asn1.ObjectIdentifier{
+		{1, 2, 840, 113549, 1, 1, 2},  // md2WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 4},  // md5WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 5},  // sha1WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 11}, // sha256WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 12}, // sha384WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 13}, // sha512WithRSAEncryption
+		{1, 2, 840, 113549, 1, 1, 14}, // sha224WithRSAEncryption
+	}
+	// Signature algorithms whose AlgorithmIdentifier parameters MUST be
+	// absent (RFC 3279: 2.2.2, 2.2.3, RFC 5758: 3.1, 3.2, RFC 8410: 3).
+	absentParamsSigAlgOIDs = []asn1.ObjectIdentifier{
+		{1, 2, 840, 10040, 4, 3},         // dsa-with-sha1
+		{2, 16, 840, 1, 101, 3, 4, 3, 1}, // dsa-with-sha224
+		{2, 16, 840, 1, 101, 3, 4, 3, 2}, // dsa-with-sha256
+		{1, 2, 840, 10045, 4, 1},         // ecdsa-with-SHA1
+		{1, 2, 840, 10045, 4, 3, 1},      // ecdsa-with-SHA224
+		{1, 2, 840, 10045, 4, 3, 2},      // ecdsa-with-SHA256
+		{1, 2, 840, 10045, 4, 3, 3},      // ecdsa-with-SHA384
+		{1, 2, 840, 10045, 4, 3, 4},      // ecdsa-with-SHA512
+		{1, 3, 101, 112},                 // id-Ed25519
+		{1, 3, 101, 113},                 // id-Ed448
+	}
+	// Signature algorithms whose AlgorithmIdentifier parameters MUST be
+	// present (RFC 4055: 3.1).
+	presentParamsSigAlgOIDs = []asn1.ObjectIdentifier{
+		OidRSASSAPSS,
+	}
+
+	RSAEncryptionOID = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	ECPublicKeyOID   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+)
+
+// An AlgorithmIdentifier is a parsed AlgorithmIdentifier that keeps track of
+// whether the optional parameters were encoded at all.
+type AlgorithmIdentifier struct {
+	Raw        []byte
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue
+}
+
+// HasParameters returns true if the parameters field is present, including
+// when it is an explicit NULL.
+func (a *AlgorithmIdentifier) HasParameters() bool {
+	return len(a.Parameters.FullBytes) > 0
+}
+
+// HasNullParameters returns true if the parameters field is an ASN.1 NULL.
+func (a *AlgorithmIdentifier) HasNullParameters() bool {
+	return a.HasParameters() && a.Parameters.Class == asn1.ClassUniversal &&
+		a.Parameters.Tag == asn1.TagNull && len(a.Parameters.Bytes) == 0
+}
+
+type rawCertificate struct {
+	TBSCertificate     rawTBSCertificate
+	SignatureAlgorithm asn1.RawValue
+	SignatureValue     asn1.BitString
+}
+
+type rawTBSCertificate struct {
+	Version      int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber asn1.RawValue
+	Signature    asn1.RawValue
+}
+
+type rawSubjectPublicKeyInfo struct {
+	Algorithm asn1.RawValue
+	PublicKey asn1.BitString
+}
+
+// ParseAlgorithmIdentifier parses a DER encoded AlgorithmIdentifier.
+func ParseAlgorithmIdentifier(der []byte) (*AlgorithmIdentifier, error) {
+	var ai struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.RawValue `asn1:"optional"`
+	}
+	if _, err := asn1.Unmarshal(der, &ai); err != nil {
+		return nil, err
+	}
+	return &AlgorithmIdentifier{Raw: der, Algorithm: ai.Algorithm, Parameters: ai.Parameters}, nil
+}
+
+// GetSignatureAlgorithms returns the signature field of the TBSCertificate
+// and the outer signatureAlgorithm of c, as they are encoded.
+func GetSignatureAlgorithms(c *x509.Certificate) (tbs *AlgorithmIdentifier, outer *AlgorithmIdentifier, err error) {
+	var raw rawCertificate
+	if _, err = asn1.Unmarshal(c.Raw, &raw); err != nil {
+		return nil, nil, err
+	}
+	if tbs, err = ParseAlgorithmIdentifier(raw.TBSCertificate.Signature.FullBytes); err != nil {
+		return nil, nil, err
+	}
+	if outer, err = ParseAlgorithmIdentifier(raw.SignatureAlgorithm.FullBytes); err != nil {
+		return nil, nil, err
+	}
+	return tbs, outer, nil
+}
+
+// GetPublicKeyAlgorithm returns the algorithm of the subjectPublicKeyInfo of
+// c, as it is encoded.
+func GetPublicKeyAlgorithm(c *x509.Certificate) (*AlgorithmIdentifier, error) {
+	var spki rawSubjectPublicKeyInfo
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, err
+	}
+	return ParseAlgorithmIdentifier(spki.Algorithm.FullBytes)
+}
+
+// SignatureParametersValid returns true if the parameters of the signature
+// AlgorithmIdentifier ai are encoded as its algorithm requires, and known is
+// false if the algorithm is not one this package has rules for.
+func SignatureParametersValid(ai *AlgorithmIdentifier) (valid bool, known bool) {
+	switch {
+	case SliceContainsOID(rsaPKCS1SigAlgOIDs, ai.Algorithm):
+		return ai.HasNullParameters(), true
+	case SliceContainsOID(absentParamsSigAlgOIDs, ai.Algorithm):
+		return !ai.HasParameters(), true
+	case SliceContainsOID(presentParamsSigAlgOIDs, ai.Algorithm):
+		return ai.HasParameters() && !ai.HasNullParameters(), true
+	}
+	return false, false
+}
@@ -16,6 +16,7 @@ package util
 
 import (
 	"encoding/asn1"
+	"errors"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
@@ -25,6 +26,7 @@ var (
 	ZeroDate                   = time.Date(0000, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC1035Date                = time.Date(1987, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC2459Date                = time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)
+	RFC3161Date                = time.Date(2001, time.August, 1, 0, 0, 0, 0, time.UTC)
 	RFC3280Date                = time.Date(2002, time.April, 1, 0, 0, 0, 0, time.UTC)
 	RFC3490Date                = time.Date(2003, time.March, 1, 0, 0, 0, 0, time.UTC)
 	RFC8399Date                = time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
@@ -32,6 +34,7 @@ var (
 	RFC4630Date                = time.Date(2006, time.August, 1, 0, 0, 0, 0, time.UTC)
 	RFC5280Date                = time.Date(2008, time.May, 1, 0, 0, 0, 0, time.UTC)
 	RFC6818Date                = time.Date(2013, time.January, 1, 0, 0, 0, 0, time.UTC)
+	RFC6960Date                = time.Date(2013, time.June, 1, 0, 0, 0, 0, time.UTC)
 	CABEffectiveDate           = time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC)
 	CABReservedIPDate          = time.Date(2016, time.October, 1, 0, 0, 0, 0, time.UTC)
 	CABGivenNameDate           = time.Date(2016, time.September, 7, 0, 0, 0, 0, time.UTC)
@@ -79,36 +82,134 @@ var (
 	BRfCSCV21MinCryptoEffectiveDate   = time.Date(2017, time.January, 31, 0, 0, 0, 0, time.UTC)
 	BRfCSCV21DigestAlgoTransitionDate = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
 	BRfCSCV21KeySizeTransitionDate    = time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	BRfCSCV30SHA1TimestampDate        = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
 
 	// CA/B Baseline Requirements Dates
 	CABBRV141Date = time.Date(2016, time.September, 7, 0, 0, 0, 0, time.UTC)
 
 	// Other
 	BRfCSCNonSequentialDate = time.Date(2016, time.September, 30, 0, 0, 0, 0, time.UTC)
+
+	// EV Guidelines 9.2.5: Registration Numbers must match a disclosed format.
+	EVRegistrationNumberFormatDate = time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC)
 )
 
 func FindTimeType(firstDate, secondDate asn1.RawValue) (int, int) {
 	return firstDate.Tag, secondDate.Tag
 }
 
+// GetTimes returns the notBefore and notAfter fields of cert as they are
+// encoded. Both are empty if the validity cannot be read.
 func GetTimes(cert *x509.Certificate) (asn1.RawValue, asn1.RawValue) {
-	var outSeq, firstDate, secondDate asn1.RawValue
-	// Unmarshal into the sequence
-	rest, err := asn1.Unmarshal(cert.RawTBSCertificate, &outSeq)
-	// Start unmarshalling the bytes
-	rest, err = asn1.Unmarshal(outSeq.Bytes, &outSeq)
-	// This is here to account for if version is not included
-	if outSeq.Tag == 0 {
-		rest, err = asn1.Unmarshal(rest, &outSeq)
-	}
-	rest, err = asn1.Unmarshal(rest, &outSeq)
-	rest, err = asn1.Unmarshal(rest, &outSeq)
-	rest, err = asn1.Unmarshal(rest, &outSeq)
-	// Finally at the validity date, load them into a different RawValue
-	rest, err = asn1.Unmarshal(outSeq.Bytes, &firstDate)
-	_, err = asn1.Unmarshal(rest, &secondDate)
+	tbs, err := ParseTBSCertificate(cert.RawTBSCertificate)
 	if err != nil {
 		return asn1.RawValue{}, asn1.RawValue{}
 	}
-	return firstDate, secondDate
+	dates, err := SequenceElements(tbs.Validity)
+	if err != nil || len(dates) != 2 {
+		return asn1.RawValue{}, asn1.RawValue{}
+	}
+	return dates[0].RawValue(), dates[1].RawValue()
+}
+
+// ValidityTimeTag returns the type RFC 5280 requires a notBefore or notAfter
+// of t to be encoded as: UTCTime through 2049, GeneralizedTime from 2050.
+func ValidityTimeTag(t time.Time) int {
+	if t.Before(GeneralizedDate) {
+		return asn1.TagUTCTime
+	}
+	return asn1.TagGeneralizedTime
+}
+
+// NoWellDefinedExpiration is the notAfter value that RFC 5280 4.1.2.5
+// reserves for certificates that have no well-defined expiration date.
+const NoWellDefinedExpiration = "99991231235959Z"
+
+// A ValidityTime describes how a notBefore or notAfter time is encoded.
+type ValidityTime struct {
+	// Tag is asn1.TagUTCTime or asn1.TagGeneralizedTime.
+	Tag int
+	// Value is the encoded time, e.g. "230101000000Z".
+	Value       string
+	HasSeconds  bool
+	HasFraction bool
+	// Zulu is true if the time ends in "Z" rather than an offset from UTC
+	// or nothing, which denotes local time.
+	Zulu bool
+}
+
+// ParseValidityTime reads the UTCTime or GeneralizedTime v, as returned by
+// GetTimes, without normalizing away the encoding choices that RFC 5280
+// restricts.
+func ParseValidityTime(v asn1.RawValue) (ValidityTime, error) {
+	t := ValidityTime{Tag: v.Tag, Value: string(v.Bytes)}
+	if v.Class != asn1.ClassUniversal {
+		return t, errors.New("validity time is not a universal type")
+	}
+	// Digits of the date and hours and minutes.
+	minutes := 10
+	switch v.Tag {
+	case asn1.TagUTCTime:
+	case asn1.TagGeneralizedTime:
+		minutes = 12
+	default:
+		return t, errors.New("validity time is neither UTCTime nor GeneralizedTime")
+	}
+	digits := 0
+	for digits < len(t.Value) && '0' <= t.Value[digits] && t.Value[digits] <= '9' {
+		digits++
+	}
+	switch digits {
+	case minutes:
+	case minutes + 2:
+		t.HasSeconds = true
+	default:
+		return t, errors.New("malformed validity time " + t.Value)
+	}
+	rest := t.Value[digits:]
+	if len(rest) > 1 && (rest[0] == '.' || rest[0] == ',') {
+		t.HasFraction = true
+		rest = rest[1:]
+		for len(rest) > 0 && '0' <= rest[0] && rest[0] <= '9' {
+			rest = rest[1:]
+		}
+	}
+	t.Zulu = rest == "Z"
+	return t, nil
+}
+
+// A PrivateKeyUsagePeriod is the decoded Private Key Usage Period extension
+// (RFC 3280: 4.2.1.4). Either bound may be absent, in which case it is the
+// zero time.
+type PrivateKeyUsagePeriod struct {
+	NotBefore time.Time `asn1:"optional,tag:0,generalized"`
+	NotAfter  time.Time `asn1:"optional,tag:1,generalized"`
+}
+
+// GetPrivateKeyUsagePeriod returns the Private Key Usage Period of cert, or
+// nil if the extension is absent.
+func GetPrivateKeyUsagePeriod(cert *x509.Certificate) (*PrivateKeyUsagePeriod, error) {
+	ext := GetExtFromCert(cert, PrivKeyUsageOID)
+	if ext == nil {
+		return nil, nil
+	}
+	var period PrivateKeyUsagePeriod
+	if _, err := asn1.Unmarshal(ext.Value, &period); err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
+
+// SigningWindow returns the period during which the private key of cert may
+// be used: its validity period, narrowed by the Private Key Usage Period when
+// one is present.
+func (p *PrivateKeyUsagePeriod) SigningWindow(cert *x509.Certificate) (notBefore, notAfter time.Time) {
+	notBefore, notAfter = cert.NotBefore, cert.NotAfter
+	if !p.NotBefore.IsZero() && p.NotBefore.After(notBefore) {
+		notBefore = p.NotBefore
+	}
+	if !p.NotAfter.IsZero() && p.NotAfter.Before(notAfter) {
+		notAfter = p.NotAfter
+	}
+	return notBefore, notAfter
 }
@@ -0,0 +1,85 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// A CertURL is a URL that a certificate directs relying parties to in order
+// to fetch its issuer or check its revocation status.
+type CertURL struct {
+	// Field is "caIssuers" or "OCSP" for URLs of the
+	// authorityInformationAccess extension, or "cRLDistributionPoints".
+	Field string
+	URL   string
+}
+
+// GetCertURLs returns the caIssuers and OCSP URLs of the
+// authorityInformationAccess extension of c and the URLs of its
+// cRLDistributionPoints, in that order.
+func GetCertURLs(c *x509.Certificate) []CertURL {
+	var out []CertURL
+	for _, u := range c.IssuingCertificateURL {
+		out = append(out, CertURL{"caIssuers", u})
+	}
+	for _, u := range c.OCSPServer {
+		out = append(out, CertURL{"OCSP", u})
+	}
+	for _, u := range c.CRLDistributionPoints {
+		out = append(out, CertURL{"cRLDistributionPoints", u})
+	}
+	return out
+}
+
+// URLScheme returns the scheme of uri in lower case, or "" if uri cannot be
+// parsed or has no scheme.
+func URLScheme(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Scheme)
+}
+
+// URLHostPort returns the host and port of the authority of uri. Both are
+// empty if uri has no authority, and port is empty if the authority does not
+// give one. The brackets of an IPv6 literal are removed.
+func URLHostPort(uri string) (host, port string) {
+	auth := GetAuthority(uri)
+	if at := strings.LastIndex(auth, "@"); at >= 0 {
+		auth = auth[at+1:]
+	}
+	if strings.HasPrefix(auth, "[") {
+		end := strings.Index(auth, "]")
+		if end < 0 {
+			return auth, ""
+		}
+		host, auth = auth[1:end], auth[end+1:]
+	} else {
+		host = GetHost(auth)
+		auth = auth[len(host):]
+	}
+	return host, strings.TrimPrefix(auth, ":")
+}
+
+// URLHasUserInfo returns true if the authority of uri contains a user name
+// or password.
+func URLHasUserInfo(uri string) bool {
+	return strings.Contains(GetAuthority(uri), "@")
+}
@@ -0,0 +1,109 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package weakkeys looks up public keys in blocklists of keys known to be
+// compromised, such as the keys generated by Debian's predictable OpenSSL
+// (CVE-2008-0166).
+package weakkeys
+
+import (
+	"bufio"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// A Blocklist is a set of RSA moduli fingerprints in the format of the
+// openssl-blacklist package: the last 20 hex digits of the SHA-1 digest of
+// "Modulus=<N in upper case hex>\n", one per line. Full 40 digit digests
+// are also accepted.
+type Blocklist struct {
+	fingerprints map[string]bool
+}
+
+// NewBlocklist returns an empty Blocklist.
+func NewBlocklist() *Blocklist {
+	return &Blocklist{fingerprints: make(map[string]bool)}
+}
+
+// Len returns the number of fingerprints in the blocklist.
+func (b *Blocklist) Len() int {
+	return len(b.fingerprints)
+}
+
+// Append reads fingerprints from r. Blank lines and lines starting with '#'
+// are skipped.
+func (b *Blocklist) Append(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fp := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if fp == "" || strings.HasPrefix(fp, "#") {
+			continue
+		}
+		if _, err := hex.DecodeString(fp); err != nil || (len(fp) != 20 && len(fp) != 40) {
+			return fmt.Errorf("line %d: %q is not a fingerprint", line, fp)
+		}
+		b.fingerprints[fp[len(fp)-20:]] = true
+	}
+	return scanner.Err()
+}
+
+// AppendFromPath loads the blocklist file at path, or every file under path
+// when it is a directory.
+func (b *Blocklist) AppendFromPath(path string) error {
+	return filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := b.Append(f); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		return nil
+	})
+}
+
+// Fingerprint returns the blocklist fingerprint of key.
+func Fingerprint(key *rsa.PublicKey) string {
+	sum := sha1.Sum([]byte("Modulus=" + strings.ToUpper(key.N.Text(16)) + "\n"))
+	return hex.EncodeToString(sum[:])[20:]
+}
+
+// Contains returns true if key is in the blocklist.
+func (b *Blocklist) Contains(key *rsa.PublicKey) bool {
+	return b.fingerprints[Fingerprint(key)]
+}
+
+var defaultBlocklist = NewBlocklist()
+
+// Default returns the Blocklist used by lints. Until SetDefault is called it
+// is empty.
+func Default() *Blocklist {
+	return defaultBlocklist
+}
+
+// SetDefault replaces the Blocklist returned by Default. It is normally
+// called once, before linting, with the files given on the command line.
+func SetDefault(b *Blocklist) {
+	defaultBlocklist = b
+}
@@ -0,0 +1,54 @@
+/*
+ * ZLint Copyright 2023 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package weakkeys
+
+import (
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	key := &rsa.PublicKey{N: big.NewInt(0xc0ffee), E: 65537}
+	sum := sha1.Sum([]byte("Modulus=C0FFEE\n"))
+	if got, want := Fingerprint(key), hex.EncodeToString(sum[:])[20:]; got != want {
+		t.Errorf("expected fingerprint %s, got %s", want, got)
+	}
+}
+
+func TestBlocklist(t *testing.T) {
+	short := &rsa.PublicKey{N: big.NewInt(0xc0ffee), E: 65537}
+	full := &rsa.PublicKey{N: big.NewInt(0xbadc0de), E: 65537}
+	other := &rsa.PublicKey{N: big.NewInt(0xfeed), E: 65537}
+	sum := sha1.Sum([]byte("Modulus=BADC0DE\n"))
+
+	b := NewBlocklist()
+	err := b.Append(strings.NewReader("# comment\n\n" + strings.ToUpper(Fingerprint(short)) + "\n" + hex.EncodeToString(sum[:]) + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() != 2 {
+		t.Errorf("expected 2 fingerprints, got %d", b.Len())
+	}
+	if !b.Contains(short) || !b.Contains(full) || b.Contains(other) {
+		t.Error("expected only the listed keys to be blocked")
+	}
+	if err := b.Append(strings.NewReader("not a fingerprint\n")); err == nil {
+		t.Error("expected an error for a malformed line")
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zcrypto/x509/revocation/ocsp"
 	"github.com/moa-lab/code-signing-certs-lint/tree/main/glint/lints"
 	//"glint/lints"
 )
@@ -48,6 +50,33 @@ func (z *ResultSet) execute(cert *x509.Certificate) {
 	}
 }
 
+func (z *ResultSet) executeCRL(crl *pkix.CertificateList) {
+	z.Results = make(map[string]*lints.LintResult, len(lints.CRLLints))
+	for name, l := range lints.CRLLints {
+		res := l.Execute(crl)
+		z.Results[name] = res
+		z.updateErrorStatePresent(res)
+	}
+}
+
+func (z *ResultSet) executeOCSP(resp *ocsp.BasicOCSPResponse) {
+	z.Results = make(map[string]*lints.LintResult, len(lints.OCSPLints))
+	for name, l := range lints.OCSPLints {
+		res := l.Execute(resp)
+		z.Results[name] = res
+		z.updateErrorStatePresent(res)
+	}
+}
+
+func (z *ResultSet) executeCSR(csr *x509.CertificateRequest) {
+	z.Results = make(map[string]*lints.LintResult, len(lints.CSRLints))
+	for name, l := range lints.CSRLints {
+		res := l.Execute(csr)
+		z.Results[name] = res
+		z.updateErrorStatePresent(res)
+	}
+}
+
 func (z *ResultSet) updateErrorStatePresent(result *lints.LintResult) {
 	switch result.Status {
 	case lints.Notice:
@@ -69,6 +98,18 @@ func EncodeLintDescriptionsToJSON(w io.Writer) {
 	for _, lint := range lints.Lints {
 		enc.Encode(lint)
 	}
+	for _, lint := range lints.CRLLints {
+		enc.Encode(lint)
+	}
+	for _, lint := range lints.OCSPLints {
+		enc.Encode(lint)
+	}
+	for _, lint := range lints.CSRLints {
+		enc.Encode(lint)
+	}
+	for _, lint := range lints.CorpusLints {
+		enc.Encode(lint)
+	}
 }
 
 // LintCertificate runs all registered lints on c, producing a ZLint.
@@ -86,3 +127,74 @@ func LintCertificate(c *x509.Certificate) *ResultSet {
 	res.Timestamp = time.Now().Unix()
 	return res
 }
+
+// LintCRL runs all registered CRL lints on crl, producing a ZLint.
+func LintCRL(crl *pkix.CertificateList) *ResultSet {
+	if crl == nil {
+		return nil
+	}
+
+	res := new(ResultSet)
+	res.executeCRL(crl)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintOCSPResponse runs all registered OCSP lints on resp, producing a ZLint.
+func LintOCSPResponse(resp *ocsp.BasicOCSPResponse) *ResultSet {
+	if resp == nil {
+		return nil
+	}
+
+	res := new(ResultSet)
+	res.executeOCSP(resp)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintCertificateRequest runs all registered CSR lints on csr alone, without
+// building a certificate. These cover what the applicant controls, such as
+// the requested key and subject.
+func LintCertificateRequest(csr *x509.CertificateRequest) *ResultSet {
+	if csr == nil {
+		return nil
+	}
+
+	res := new(ResultSet)
+	res.executeCSR(csr)
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintCorpus runs all registered corpus lints over entries, producing a
+// ZLint for each entry by ID. Corpus lints keep state between certificates,
+// so LintCorpus must not be called concurrently.
+func LintCorpus(entries []*lints.CorpusEntry) (map[string]*ResultSet, error) {
+	out := make(map[string]*ResultSet, len(entries))
+	for _, e := range entries {
+		if _, ok := out[e.ID]; !ok {
+			out[e.ID] = &ResultSet{Results: make(map[string]*lints.LintResult, len(lints.CorpusLints))}
+		}
+	}
+	for name, l := range lints.CorpusLints {
+		results, err := l.ExecuteCorpus(entries)
+		if err != nil {
+			return nil, err
+		}
+		for id, res := range results {
+			out[id].Results[name] = res
+		}
+	}
+	now := time.Now().Unix()
+	for _, res := range out {
+		for _, r := range res.Results {
+			res.updateErrorStatePresent(r)
+		}
+		res.Version = Version
+		res.Timestamp = now
+	}
+	return out, nil
+}